@@ -1,6 +1,6 @@
 module github.com/0x5a17ed/itkit
 
-go 1.20
+go 1.23
 
 require (
 	github.com/0x5a17ed/coro v1.1.0
@@ -0,0 +1,70 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ittest provides fixtures shared by the tests of the
+// iterator packages.
+package ittest
+
+import (
+	"errors"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// ErrBroken is the error reported by a [BrokenIterator].
+var ErrBroken = errors.New("broken")
+
+// BrokenIterator yields the items of the wrapped iterator and
+// reports [ErrBroken] once they are exhausted.
+type BrokenIterator[T any] struct {
+	itkit.Iterator[T]
+	err error
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *BrokenIterator[T]) Next() bool {
+	if it.err == nil && it.Iterator.Next() {
+		return true
+	}
+	it.err = ErrBroken
+	return false
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *BrokenIterator[T]) Err() error { return it.err }
+
+// Failing returns a [BrokenIterator] yielding the given items.
+func Failing[T any](items ...T) itkit.Iterator[T] {
+	return &BrokenIterator[T]{Iterator: sliceit.In(items)}
+}
+
+// CloseIterator yields the items of the wrapped iterator and counts
+// how often it has been closed.
+type CloseIterator[T any] struct {
+	itkit.Iterator[T]
+	Closed int
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *CloseIterator[T]) Err() error { return itkit.Err(it.Iterator) }
+
+// Close implements the [io.Closer] interface.
+func (it *CloseIterator[T]) Close() error { it.Closed += 1; return nil }
+
+// Closing returns a [CloseIterator] yielding the numbers [0 .. n).
+func Closing(n int) *CloseIterator[int] {
+	return &CloseIterator[int]{Iterator: rangeit.Range(n)}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package itclock provides an injectable source of time for
+// iterators depending on the passing of time.
+package itclock
+
+import (
+	"context"
+	"time"
+)
+
+// A Timer delivers the current time on its channel once it fires.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+
+	// Stop prevents the Timer from firing.  It returns false if
+	// the Timer already fired or has been stopped.
+	Stop() bool
+}
+
+// A Clock tells the current time and creates timers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer creates a new [Timer] firing after at least the
+	// duration d passed.
+	NewTimer(d time.Duration) Timer
+}
+
+type realTimer struct{ *time.Timer }
+
+func (t realTimer) C() <-chan time.Time { return t.Timer.C }
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+// Real is the [Clock] backed by the functions of the [time] package.
+var Real Clock = realClock{}
+
+// OrReal returns the given [Clock] c if not nil and [Real] otherwise.
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real
+	}
+	return c
+}
+
+// SleepFn blocks for the duration d or until the given context is
+// done, returning the error of the context in the latter case.
+type SleepFn func(ctx context.Context, d time.Duration) error
+
+// Sleep blocks until the duration d passed on the given [Clock] c or
+// until the given context is done, returning the error of the
+// context in the latter case.
+func Sleep(ctx context.Context, c Clock, d time.Duration) error {
+	if err := ctx.Err(); err != nil || d <= 0 {
+		return err
+	}
+
+	t := c.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
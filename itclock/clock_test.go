@@ -0,0 +1,93 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itclock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/itclock"
+)
+
+func TestOrReal(t *testing.T) {
+	assert.Equal(t, itclock.Real, itclock.OrReal(nil))
+
+	clk := itclock.NewFake(time.Unix(0, 0))
+	assert.Equal(t, itclock.Clock(clk), itclock.OrReal(clk))
+}
+
+func TestFake(t *testing.T) {
+	start := time.Unix(0, 0)
+	clk := itclock.NewFake(start)
+	assert.Equal(t, start, clk.Now())
+
+	t1 := clk.NewTimer(time.Second)
+	t2 := clk.NewTimer(2 * time.Second)
+	t3 := clk.NewTimer(3 * time.Second)
+	assert.Equal(t, 3, clk.Timers())
+
+	clk.Advance(time.Second)
+	assert.Equal(t, start.Add(time.Second), clk.Now())
+	assert.Equal(t, start.Add(time.Second), <-t1.C())
+	assert.Len(t, t2.C(), 0)
+	assert.Equal(t, 2, clk.Timers())
+
+	assert.True(t, t3.Stop())
+	assert.False(t, t3.Stop())
+	assert.False(t, t1.Stop())
+
+	clk.Advance(time.Hour)
+	assert.Equal(t, start.Add(time.Hour+time.Second), <-t2.C())
+	assert.Len(t, t3.C(), 0)
+	assert.Equal(t, 0, clk.Timers())
+}
+
+func TestFake_Immediate(t *testing.T) {
+	clk := itclock.NewFake(time.Unix(0, 0))
+
+	tm := clk.NewTimer(0)
+	assert.Equal(t, time.Unix(0, 0), <-tm.C())
+	assert.Equal(t, 0, clk.Timers())
+}
+
+func TestFake_BlockUntil(t *testing.T) {
+	clk := itclock.NewFake(time.Unix(0, 0))
+
+	go clk.NewTimer(time.Second)
+	clk.BlockUntil(1)
+	assert.Equal(t, 1, clk.Timers())
+}
+
+func TestSleep(t *testing.T) {
+	clk := itclock.NewFake(time.Unix(0, 0))
+
+	assert.NoError(t, itclock.Sleep(context.Background(), clk, 0))
+
+	done := make(chan error)
+	go func() { done <- itclock.Sleep(context.Background(), clk, time.Second) }()
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	assert.NoError(t, <-done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { done <- itclock.Sleep(ctx, clk, time.Second) }()
+	clk.BlockUntil(1)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, 0, clk.Timers())
+}
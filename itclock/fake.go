@@ -0,0 +1,125 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itclock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+type fakeTimer struct {
+	clk *Fake
+	at  time.Time
+	c   chan time.Time
+}
+
+// C implements the [Timer.C] interface.
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+// Stop implements the [Timer.Stop] interface.
+func (t *fakeTimer) Stop() bool {
+	t.clk.mx.Lock()
+	defer t.clk.mx.Unlock()
+
+	i := slices.Index(t.clk.timers, t)
+	if i < 0 {
+		return false
+	}
+	t.clk.timers = slices.Delete(t.clk.timers, i, i+1)
+	t.clk.cond.Broadcast()
+	return true
+}
+
+// Fake is a [Clock] whose time only moves when told so, allowing
+// for deterministic tests.
+//
+// A Fake is safe to use in goroutines.
+type Fake struct {
+	mx     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// Ensure Fake conforms to the Clock protocol.
+var _ Clock = &Fake{}
+
+// NewFake returns a new [Fake] clock starting at the given time.
+func NewFake(now time.Time) *Fake {
+	c := &Fake{now: now}
+	c.cond = sync.NewCond(&c.mx)
+	return c
+}
+
+// Now implements the [Clock.Now] interface.
+func (c *Fake) Now() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.now
+}
+
+// NewTimer implements the [Clock.NewTimer] interface.
+func (c *Fake) NewTimer(d time.Duration) Timer {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	t := &fakeTimer{clk: c, at: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		t.c <- c.now
+		return t
+	}
+
+	c.timers = append(c.timers, t)
+	c.cond.Broadcast()
+	return t
+}
+
+// Advance moves the time of the clock forward by the duration d,
+// firing all timers due until then.
+func (c *Fake) Advance(d time.Duration) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.now = c.now.Add(d)
+	c.timers = slices.DeleteFunc(c.timers, func(t *fakeTimer) bool {
+		if t.at.After(c.now) {
+			return false
+		}
+		t.c <- c.now
+		return true
+	})
+	c.cond.Broadcast()
+}
+
+// Timers returns the number of timers not fired or stopped yet.
+func (c *Fake) Timers() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return len(c.timers)
+}
+
+// BlockUntil blocks until at least n timers are waiting to fire,
+// allowing tests to synchronize with goroutines using the clock.
+func (c *Fake) BlockUntil(n int) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
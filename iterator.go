@@ -14,6 +14,10 @@
 
 package itkit
 
+import (
+	"io"
+)
+
 // An Iterator allows consuming individual items in a stream of items.
 type Iterator[T any] interface {
 	// Next advances the iterator to the first/next item,
@@ -27,3 +31,53 @@ type Iterator[T any] interface {
 	// Note: Use Next to ensure there is an item.
 	Value() T
 }
+
+// A DoubleEndedIterator is an Iterator able to yield items from both
+// ends of the stream of items.  Items yielded from one end are never
+// yielded from the other end.
+type DoubleEndedIterator[T any] interface {
+	Iterator[T]
+
+	// NextBack advances the iterator to the last/previous item
+	// from the back, returning true if successful meaning there
+	// is an item available to be fetched with Value and false
+	// otherwise.
+	NextBack() bool
+}
+
+// An ErrIterator is an Iterator which may stop yielding items early
+// because of an error.
+type ErrIterator[T any] interface {
+	Iterator[T]
+
+	// Err returns the error, if any, that stopped the iterator
+	// from yielding more items.
+	//
+	// Note: Err should be consulted once Next returned false.
+	Err() error
+}
+
+// Err returns the error reported by the given Iterator it if it
+// implements the [ErrIterator] protocol and nil otherwise.
+func Err[T any](it Iterator[T]) error {
+	if e, ok := it.(ErrIterator[T]); ok {
+		return e.Err()
+	}
+	return nil
+}
+
+// A CloseIterator is an Iterator holding resources which must be
+// released by calling Close once the iterator is no longer used.
+type CloseIterator[T any] interface {
+	Iterator[T]
+	io.Closer
+}
+
+// Close releases the resources held by the given Iterator it if it
+// implements the [io.Closer] interface, returning its error.
+func Close[T any](it Iterator[T]) error {
+	if c, ok := it.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -15,6 +15,8 @@
 package chanit
 
 import (
+	"context"
+
 	"github.com/0x5a17ed/itkit"
 )
 
@@ -28,8 +30,52 @@ type ChannelIterator[T any] struct {
 func (it *ChannelIterator[T]) Value() T        { return it.v }
 func (it *ChannelIterator[T]) Next() (ok bool) { it.v, ok = <-it.ch; return }
 
+// Chan returns the channel the [ChannelIterator] retrieves items
+// from.
+func (it *ChannelIterator[T]) Chan() <-chan T { return it.ch }
+
 // In provides an Iterator which yields items retrieved from the
 // given Go channel until the channel is closed.
 func In[T any](ch <-chan T) itkit.Iterator[T] {
 	return &ChannelIterator[T]{ch: ch}
 }
+
+// ContextChannelIterator represents an iterator which yields items
+// retrieved from a Go channel until the channel is closed or a given
+// context is done.
+type ContextChannelIterator[T any] struct {
+	ctx context.Context
+	ch  <-chan T
+	v   T
+	err error
+}
+
+// Ensure ContextChannelIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &ContextChannelIterator[struct{}]{}
+
+func (it *ContextChannelIterator[T]) Value() T { return it.v }
+
+func (it *ContextChannelIterator[T]) Next() (ok bool) {
+	if it.err != nil {
+		return false
+	}
+
+	select {
+	case it.v, ok = <-it.ch:
+		return ok
+	case <-it.ctx.Done():
+		it.err = it.ctx.Err()
+		return false
+	}
+}
+
+// Err returns the error of the context if the context stopped the
+// iterator and nil otherwise.
+func (it *ContextChannelIterator[T]) Err() error { return it.err }
+
+// InContext provides an Iterator which yields items retrieved from
+// the given Go channel until the channel is closed or the given
+// context is done, whichever happens first.
+func InContext[T any](ctx context.Context, ch <-chan T) itkit.Iterator[T] {
+	return &ContextChannelIterator[T]{ctx: ctx, ch: ch}
+}
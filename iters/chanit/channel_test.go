@@ -15,7 +15,9 @@
 package chanit_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	assertpkg "github.com/stretchr/testify/assert"
 
@@ -33,3 +35,35 @@ func TestChannel(t *testing.T) {
 	s := sliceit.To(chanit.In(ch))
 	assertpkg.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, s)
 }
+
+func TestChannelContext(t *testing.T) {
+	t.Run("closed", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		close(ch)
+
+		s, err := sliceit.ToErr(chanit.InContext(context.Background(), ch))
+		assertpkg.Equal(t, []int{1, 2}, s)
+		assertpkg.NoError(t, err)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := make(chan int, 1)
+		ch <- 1
+
+		it := chanit.InContext(ctx, ch)
+		assertpkg.True(t, it.Next())
+		assertpkg.Equal(t, 1, it.Value())
+
+		// Cancel the context while the iterator blocks on the channel.
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		s, err := sliceit.ToErr(it)
+		assertpkg.Empty(t, s)
+		assertpkg.ErrorIs(t, err, context.Canceled)
+		assertpkg.False(t, it.Next())
+	})
+}
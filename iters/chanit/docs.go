@@ -17,4 +17,9 @@
 //
 // Iterator functions:
 //   - [In] - yields items retrieved from a native Go channel
+//   - [InContext] - like [In], stopping once a context is done
+//   - [Merge] - yields items retrieved from multiple Go channels
+//   - [MergeContext] - like [Merge], stopping once a context is done
+//   - [Out] - sends the items of an iterator to a native Go channel
+//   - [OutContext] - like [Out], stopping once a context is done
 package chanit
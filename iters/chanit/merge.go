@@ -0,0 +1,98 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chanit
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// MergeIterator represents an iterator which yields items retrieved
+// from multiple Go channels until all channels are closed.
+//
+// Items are retrieved from whichever channel has items available,
+// choosing randomly between ready channels to treat all channels
+// fairly.  Items retrieved from the same channel are yielded in the
+// order they were sent in.  No goroutines are involved.
+type MergeIterator[T any] struct {
+	ctx   context.Context
+	cases []reflect.SelectCase
+	open  int
+	v     T
+	err   error
+}
+
+// Ensure MergeIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &MergeIterator[struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *MergeIterator[T]) Next() bool {
+	for it.err == nil && it.open > 0 {
+		chosen, recv, ok := reflect.Select(it.cases)
+		switch {
+		case chosen == len(it.cases)-1 && it.ctx != nil:
+			it.err = it.ctx.Err()
+			return false
+		case !ok:
+			// Ignore the closed channel from now on.
+			it.cases[chosen].Chan = reflect.Value{}
+			it.open -= 1
+			continue
+		}
+
+		// Channels of interface types yield nil interfaces.
+		it.v, _ = recv.Interface().(T)
+		return true
+	}
+	return false
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *MergeIterator[T]) Value() T { return it.v }
+
+// Err returns the error of the context if the context stopped the
+// iterator and nil otherwise.
+func (it *MergeIterator[T]) Err() error { return it.err }
+
+func newMerge[T any](ctx context.Context, chs []<-chan T) *MergeIterator[T] {
+	it := &MergeIterator[T]{ctx: ctx, open: len(chs)}
+
+	it.cases = make([]reflect.SelectCase, len(chs), len(chs)+1)
+	for i, ch := range chs {
+		it.cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+	if ctx != nil {
+		it.cases = append(it.cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ctx.Done()),
+		})
+	}
+	return it
+}
+
+// Merge provides an Iterator which yields items retrieved from all
+// given Go channels until all channels are closed.
+func Merge[T any](chs ...<-chan T) itkit.Iterator[T] {
+	return newMerge(nil, chs)
+}
+
+// MergeContext provides an Iterator which yields items retrieved
+// from all given Go channels until all channels are closed or the
+// given context is done, whichever happens first.
+func MergeContext[T any](ctx context.Context, chs ...<-chan T) itkit.Iterator[T] {
+	return newMerge(ctx, chs)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chanit_test
+
+import (
+	"context"
+	"testing"
+
+	assertpkg "github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/chanit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assertpkg.False(t, chanit.Merge[int]().Next())
+	})
+
+	t.Run("order", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		chs := make([]<-chan int, 3)
+		for i := range chs {
+			chs[i] = chanit.Out(rangeit.RangeFrom(i*100, i*100+50), 0).C
+		}
+
+		s := sliceit.To(chanit.Merge(chs...))
+		assertpkg.Len(t, s, 150)
+
+		// Items of every channel keep their relative order.
+		groups := itlib.GroupByMap(sliceit.In(s), func(v int) int { return v / 100 })
+		for i := range chs {
+			assertpkg.Equal(t, sliceit.To(rangeit.RangeFrom(i*100, i*100+50)), groups[i])
+		}
+	})
+
+	t.Run("fair", func(t *testing.T) {
+		a, b := make(chan int, 100), make(chan int, 100)
+		for i := 0; i < 100; i++ {
+			a <- 0
+			b <- 1
+		}
+		close(a)
+		close(b)
+
+		var counts [2]int
+		for it := itlib.Limit(100, chanit.Merge[int](a, b)); it.Next(); {
+			counts[it.Value()] += 1
+		}
+		assertpkg.Greater(t, counts[0], 10)
+		assertpkg.Greater(t, counts[1], 10)
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		ch := make(chan error, 1)
+		ch <- nil
+		close(ch)
+
+		assertpkg.Equal(t, []error{nil}, sliceit.To(chanit.Merge[error](ch)))
+	})
+}
+
+func TestMergeContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	a, b := make(chan int, 1), make(chan int)
+	a <- 1
+	close(a)
+
+	it := chanit.MergeContext[int](ctx, a, b)
+	assertpkg.True(t, it.Next())
+	assertpkg.Equal(t, 1, it.Value())
+
+	cancel()
+	assertpkg.False(t, it.Next())
+	assertpkg.ErrorIs(t, itkit.Err(it), context.Canceled)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chanit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// errStopped is the cause of contexts canceled by [Pump.Stop].
+var errStopped = errors.New("pump stopped")
+
+// Pump represents a goroutine sending the items of an iterator to a
+// Go channel.
+//
+// The goroutine exits once the iterator is exhausted or the pump is
+// stopped, closing the channel.  A pump abandoned before that must
+// be stopped with [Pump.Stop] or by canceling its context.
+type Pump[T any] struct {
+	// C is the channel the items are sent to.
+	C <-chan T
+
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+	err    error
+}
+
+// Done returns a channel closed once the goroutine exited.
+func (p *Pump[T]) Done() <-chan struct{} {
+	return p.done
+}
+
+// Err returns the error reported by the iterator or the error of the
+// context if the context stopped the pump.  Err returns nil while
+// the goroutine is still running.
+func (p *Pump[T]) Err() error {
+	select {
+	case <-p.done:
+		return p.err
+	default:
+		return nil
+	}
+}
+
+// Wait waits for the goroutine to exit and returns the error as
+// reported by [Pump.Err].
+func (p *Pump[T]) Wait() error {
+	<-p.done
+	return p.err
+}
+
+// Stop stops the pump and waits for the goroutine to exit, returning
+// the error as reported by [Pump.Err].  Stopping the pump does not
+// count as an error.
+//
+// Stop waits for a pending call to the Next method of the iterator
+// to return.
+func (p *Pump[T]) Stop() error {
+	p.cancel(errStopped)
+	return p.Wait()
+}
+
+func (p *Pump[T]) run(ctx context.Context, it itkit.Iterator[T], ch chan<- T) {
+	defer close(p.done)
+	defer close(ch)
+	defer p.cancel(nil)
+
+	for it.Next() {
+		select {
+		case ch <- it.Value():
+			continue
+		case <-ctx.Done():
+		}
+
+		if err := context.Cause(ctx); !errors.Is(err, errStopped) {
+			p.err = err
+		}
+		_ = itkit.Close(it)
+		return
+	}
+	p.err = itkit.Err(it)
+}
+
+// OutContext starts a [Pump] sending the items of the given iterator
+// to a channel with a buffer of n items until the iterator is
+// exhausted or the given context is done, whichever happens first.
+//
+// The iterator is closed when stopped before being exhausted.
+func OutContext[T any](ctx context.Context, it itkit.Iterator[T], n int) *Pump[T] {
+	ch := make(chan T, n)
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	p := &Pump[T]{C: ch, cancel: cancel, done: make(chan struct{})}
+	go p.run(ctx, it, ch)
+	return p
+}
+
+// Out starts a [Pump] sending the items of the given iterator to a
+// channel with a buffer of n items.
+func Out[T any](it itkit.Iterator[T], n int) *Pump[T] {
+	return OutContext(context.Background(), it, n)
+}
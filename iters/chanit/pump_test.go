@@ -0,0 +1,83 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chanit_test
+
+import (
+	"context"
+	"testing"
+
+	assertpkg "github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/chanit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+func TestOut(t *testing.T) {
+	t.Run("exhausted", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		p := chanit.Out(rangeit.Range(5), 2)
+		assertpkg.Equal(t, []int{0, 1, 2, 3, 4}, sliceit.To(chanit.In(p.C)))
+		assertpkg.NoError(t, p.Wait())
+
+		_, ok := <-p.Done()
+		assertpkg.False(t, ok)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		src := &ittest.CloseIterator[int]{Iterator: &ittest.BrokenIterator[int]{Iterator: rangeit.Range(3)}}
+		p := chanit.Out[int](src, 0)
+		assertpkg.NoError(t, p.Err())
+
+		assertpkg.Equal(t, []int{0, 1, 2}, sliceit.To(chanit.In(p.C)))
+		assertpkg.ErrorIs(t, p.Wait(), ittest.ErrBroken)
+		assertpkg.ErrorIs(t, p.Err(), ittest.ErrBroken)
+		assertpkg.Equal(t, 0, src.Closed)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		src := &ittest.CloseIterator[int]{Iterator: &ittest.BrokenIterator[int]{Iterator: rangeit.Count[int]()}}
+		p := chanit.Out[int](src, 1)
+		assertpkg.Equal(t, 0, <-p.C)
+
+		assertpkg.NoError(t, p.Stop())
+		assertpkg.Equal(t, 1, src.Closed)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		src := &ittest.CloseIterator[int]{Iterator: &ittest.BrokenIterator[int]{Iterator: rangeit.Count[int]()}}
+		p := chanit.OutContext[int](ctx, src, 0)
+		assertpkg.Equal(t, 0, <-p.C)
+
+		cancel()
+		assertpkg.ErrorIs(t, p.Wait(), context.Canceled)
+		assertpkg.Equal(t, 1, src.Closed)
+
+		// The channel is closed once the pump stopped.
+		for range p.C {
+		}
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecit_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/codecit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+type person struct {
+	Name    string  `json:"name"`
+	Age     int     `json:"age" csv:"age"`
+	Score   float64 `json:"score,omitempty"`
+	Ignored string  `json:"-" csv:"-"`
+}
+
+func TestInJSONLines(t *testing.T) {
+	in := "{\"name\":\"alice\",\"age\":31}\r\n\n  \n{\"name\":\"bob\",\"age\":42,\"score\":1.5}"
+
+	it := codecit.InJSONLines[person](strings.NewReader(in))
+	s, err := sliceit.ToErr(it.Iter())
+	assert.NoError(t, err)
+	assert.Equal(t, []person{{Name: "alice", Age: 31}, {Name: "bob", Age: 42, Score: 1.5}}, s)
+}
+
+func TestInJSONLines_Err(t *testing.T) {
+	in := "{\"age\":1}\n{\"age\":\"two\"}\n\n{bad\n{\"age\":4}\n"
+
+	t.Run("stop", func(t *testing.T) {
+		it := codecit.InJSONLines[person](strings.NewReader(in))
+
+		s, err := sliceit.ToErr(it.Iter())
+		assert.Equal(t, []person{{Age: 1}}, s)
+
+		var re *codecit.RecordError
+		if assert.ErrorAs(t, err, &re) {
+			assert.Equal(t, 2, re.Line)
+		}
+		assert.False(t, it.Next())
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		var lines []int
+		it := codecit.InJSONLines[person](strings.NewReader(in))
+		it.Policy = codecit.SkipOnError
+		it.OnError = func(err *codecit.RecordError) { lines = append(lines, err.Line) }
+
+		s, err := sliceit.ToErr(it.Iter())
+		assert.NoError(t, err)
+		assert.Equal(t, []person{{Age: 1}, {Age: 4}}, s)
+		assert.Equal(t, 2, it.Skipped)
+		assert.Equal(t, []int{2, 4}, lines)
+	})
+
+	t.Run("read", func(t *testing.T) {
+		r := io.MultiReader(strings.NewReader("{\"age\":1}\n"), iotest.ErrReader(ittest.ErrBroken))
+		it := codecit.InJSONLines[person](r)
+		it.Policy = codecit.SkipOnError
+
+		s, err := sliceit.ToErr(it.Iter())
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+		assert.Equal(t, []person{{Age: 1}}, s)
+	})
+}
+
+func TestToJSONLines(t *testing.T) {
+	var b strings.Builder
+	err := codecit.ToJSONLines(&b, sliceit.In([]person{{Name: "alice", Age: 31}, {Name: "bob", Score: 2}}))
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"name\":\"alice\",\"age\":31}\n{\"name\":\"bob\",\"age\":0,\"score\":2}\n", b.String())
+
+	it := codecit.InJSONLines[person](strings.NewReader(b.String()))
+	assert.Equal(t, []person{{Name: "alice", Age: 31}, {Name: "bob", Score: 2}}, sliceit.To(it.Iter()))
+}
+
+type event struct {
+	At     time.Time `csv:"at"`
+	Kind   string
+	Count  uint8
+	Active bool
+}
+
+func TestInCSV(t *testing.T) {
+	in := "kind,at,extra,count,active\n" +
+		"start,2023-01-02T03:04:05Z,x,3,true\n" +
+		"\"stop\nnow\",2023-01-02T03:04:06Z,,,\n"
+
+	it := codecit.InCSV[event](strings.NewReader(in))
+	s, err := sliceit.ToErr(it.Iter())
+	assert.NoError(t, err)
+	assert.Equal(t, []event{
+		{At: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), Kind: "start", Count: 3, Active: true},
+		{At: time.Date(2023, 1, 2, 3, 4, 6, 0, time.UTC), Kind: "stop\nnow"},
+	}, s)
+}
+
+func TestInCSV_Empty(t *testing.T) {
+	it := codecit.InCSV[event](strings.NewReader(""))
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
+
+func TestInCSV_Err(t *testing.T) {
+	in := "Name,age\n" +
+		"alice,31\n" +
+		"bob,many\n" +
+		"carol\n" +
+		"\"dave\"x,1\n" +
+		"erin,256\n"
+
+	t.Run("stop", func(t *testing.T) {
+		it := codecit.InCSV[person](strings.NewReader(in))
+
+		s, err := sliceit.ToErr(it.Iter())
+		assert.Equal(t, []person{{Name: "alice", Age: 31}}, s)
+
+		var re *codecit.RecordError
+		if assert.ErrorAs(t, err, &re) {
+			assert.Equal(t, 3, re.Line)
+			assert.ErrorContains(t, err, `line 3: column "age"`)
+		}
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		var lines []int
+		it := codecit.InCSV[person](strings.NewReader(in))
+		it.Policy = codecit.SkipOnError
+		it.OnError = func(err *codecit.RecordError) { lines = append(lines, err.Line) }
+
+		s, err := sliceit.ToErr(it.Iter())
+		assert.NoError(t, err)
+		assert.Equal(t, []person{{Name: "alice", Age: 31}, {Name: "erin", Age: 256}}, s)
+		assert.Equal(t, 3, it.Skipped)
+		assert.Equal(t, []int{3, 4, 5}, lines)
+	})
+
+	t.Run("type", func(t *testing.T) {
+		it := codecit.InCSV[int](strings.NewReader(in))
+		assert.False(t, it.Next())
+		assert.Error(t, it.Err())
+	})
+}
+
+func TestToCSV(t *testing.T) {
+	var b strings.Builder
+	err := codecit.ToCSV(&b, sliceit.In([]person{
+		{Name: "alice", Age: 31, Score: 0.5, Ignored: "x"},
+		{Name: "bob, jr.", Age: 42},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "Name,age,Score\nalice,31,0.5\n\"bob, jr.\",42,0\n", b.String())
+
+	it := codecit.InCSV[person](strings.NewReader(b.String()))
+	assert.Equal(t, []person{
+		{Name: "alice", Age: 31, Score: 0.5},
+		{Name: "bob, jr.", Age: 42},
+	}, sliceit.To(it.Iter()))
+}
+
+func TestToCSV_Err(t *testing.T) {
+	type unsupported struct{ Values []int }
+
+	var b strings.Builder
+	err := codecit.ToCSV(&b, sliceit.In([]unsupported{{}}))
+	assert.ErrorContains(t, err, "unsupported type")
+
+	err = codecit.ToCSV(io.Discard, sliceit.In([]int{1}))
+	assert.Error(t, err)
+
+	src := &ittest.CloseIterator[int]{Iterator: sliceit.In([]int{1})}
+	assert.Error(t, codecit.ToCSV(io.Discard, src))
+	assert.Equal(t, 1, src.Closed)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecit
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// CSVIterator yields structs decoded from the rows read from an
+// [io.Reader] holding CSV records.
+//
+// The first row is the header naming the columns, each column is
+// mapped to the struct field of the same name, or the name given in
+// its "csv" struct tag.  Columns not mapped to any field are ignored.
+//
+// Fields can be of any string, boolean or numeric type, or implement
+// the [encoding.TextUnmarshaler] interface.  Empty cells leave
+// fields not implementing [encoding.TextUnmarshaler] at their zero
+// value.
+//
+// The configuration fields must not be changed once the first item
+// has been retrieved from the iterator.
+type CSVIterator[T any] struct {
+	// Policy specifies how rows failing to decode are dealt with.
+	Policy ErrorPolicy
+
+	// Reader is the [csv.Reader] the rows are read from, which
+	// can be configured before retrieving the first item.
+	Reader *csv.Reader
+
+	// Skipped counts the rows skipped due to [SkipOnError].
+	Skipped int
+
+	// OnError, if not nil, is called with the error of every row
+	// skipped due to [SkipOnError].
+	OnError func(*RecordError)
+
+	started bool
+	header  []string
+	columns []int
+	cur     T
+	err     error
+}
+
+// Ensure CSVIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &CSVIterator[struct{}]{}
+
+func (it *CSVIterator[T]) start() bool {
+	it.started = true
+
+	fields, err := structFields(reflect.TypeFor[T]())
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	header, err := it.Reader.Read()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			it.err = err
+		}
+		return false
+	}
+	it.header = append([]string(nil), header...)
+	it.columns = mapHeader(fields, it.header)
+	return true
+}
+
+func (it *CSVIterator[T]) decode(row []string) (v T, err error) {
+	rv := reflect.ValueOf(&v).Elem()
+	for i, idx := range it.columns {
+		if idx < 0 || i >= len(row) {
+			continue
+		}
+		if err = parseValue(rv.Field(idx), row[i]); err != nil {
+			return v, fmt.Errorf("column %q: %w", it.header[i], err)
+		}
+	}
+	return v, nil
+}
+
+// read reads the next row, returning a [RecordError] for rows
+// failing to decode.
+func (it *CSVIterator[T]) read() (v T, err error) {
+	row, err := it.Reader.Read()
+	if err != nil {
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			err = &RecordError{Line: pe.StartLine, Err: fmt.Errorf("column %d: %w", pe.Column, pe.Err)}
+		}
+		return v, err
+	}
+
+	line, _ := it.Reader.FieldPos(0)
+	if v, err = it.decode(row); err != nil {
+		err = &RecordError{Line: line, Err: err}
+	}
+	return v, err
+}
+
+func (it *CSVIterator[T]) skip(err *RecordError) {
+	it.Skipped += 1
+	if it.OnError != nil {
+		it.OnError(err)
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *CSVIterator[T]) Next() bool {
+	if it.err != nil || (!it.started && !it.start()) {
+		return false
+	}
+
+	for {
+		v, err := it.read()
+		if err == nil {
+			it.cur = v
+			return true
+		}
+
+		var re *RecordError
+		switch {
+		case errors.Is(err, io.EOF):
+			return false
+		case errors.As(err, &re) && it.Policy == SkipOnError:
+			it.skip(re)
+			continue
+		}
+		it.err = err
+		return false
+	}
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *CSVIterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error reading the input or the [RecordError] of
+// the row stopping the iterator, if any.
+func (it *CSVIterator[T]) Err() error {
+	return it.err
+}
+
+// Iter returns the [CSVIterator] as an [itkit.Iterator] value.
+func (it *CSVIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// InCSV returns a [CSVIterator] yielding structs decoded from the
+// CSV rows read from r.
+func InCSV[T any](r io.Reader) *CSVIterator[T] {
+	return &CSVIterator[T]{Reader: csv.NewReader(r)}
+}
+
+// ToCSV writes the items of the given iterator to w as CSV rows,
+// preceded by a header naming the columns.
+//
+// The columns are the exported fields of the struct T, named like
+// the fields or as given in their "csv" struct tag.  Fields tagged
+// with "-" are left out.
+//
+// ToCSV stops at the first error encoding or writing an item or the
+// header, closing the iterator, and returns the error.  Otherwise, the error
+// reported by the iterator is returned, if any.
+func ToCSV[T any](w io.Writer, it itkit.Iterator[T]) (err error) {
+	fields, err := structFields(reflect.TypeFor[T]())
+	if err != nil {
+		_ = itkit.Close(it)
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	defer func() {
+		if cw.Flush(); err == nil {
+			err = cw.Error()
+		}
+	}()
+
+	row := make([]string, len(fields))
+	for i, f := range fields {
+		row[i] = f.name
+	}
+	if err = cw.Write(row); err != nil {
+		_ = itkit.Close(it)
+		return err
+	}
+
+	for it.Next() {
+		rv := reflect.ValueOf(it.Value())
+		for i, f := range fields {
+			if row[i], err = formatValue(rv.Field(f.index)); err != nil {
+				_ = itkit.Close(it)
+				return err
+			}
+		}
+		if err = cw.Write(row); err != nil {
+			_ = itkit.Close(it)
+			return err
+		}
+	}
+	return itkit.Err(it)
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codecit allows for records encoded as JSON Lines or CSV
+// to be used with iterators.
+//
+// Iterator functions:
+//   - [InJSONLines] - yields values decoded from JSON Lines
+//   - [InCSV] - yields structs decoded from CSV rows
+//   - [ToJSONLines] - writes the items of an iterator as JSON Lines
+//   - [ToCSV] - writes the items of an iterator as CSV rows
+package codecit
@@ -0,0 +1,48 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecit
+
+import (
+	"fmt"
+)
+
+// ErrorPolicy specifies how an iterator deals with records failing
+// to decode.
+type ErrorPolicy int
+
+const (
+	// StopOnError stops the iterator at the first record failing
+	// to decode, reporting the error.
+	StopOnError ErrorPolicy = iota
+
+	// SkipOnError skips records failing to decode.
+	SkipOnError
+)
+
+// RecordError describes a record failing to decode.
+type RecordError struct {
+	// Line is the line number the record starts on, starting
+	// with 1.
+	Line int
+
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RecordError) Unwrap() error { return e.Err }
@@ -0,0 +1,135 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/ioit"
+)
+
+// JSONLinesIterator yields values decoded from an [io.Reader]
+// holding one JSON value per line.  Blank lines are ignored.
+//
+// The configuration fields must not be changed once the first item
+// has been retrieved from the iterator.
+type JSONLinesIterator[T any] struct {
+	// Policy specifies how lines failing to decode are dealt with.
+	Policy ErrorPolicy
+
+	// MaxLineSize limits the length of a line, see the field
+	// [ioit.ScanIterator.MaxTokenSize].
+	MaxLineSize int
+
+	// Skipped counts the lines skipped due to [SkipOnError].
+	Skipped int
+
+	// OnError, if not nil, is called with the error of every line
+	// skipped due to [SkipOnError].
+	OnError func(*RecordError)
+
+	src  *ioit.ScanIterator[[]byte]
+	line int
+	cur  T
+	err  error
+}
+
+// Ensure JSONLinesIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &JSONLinesIterator[struct{}]{}
+
+func (it *JSONLinesIterator[T]) skip(err *RecordError) {
+	it.Skipped += 1
+	if it.OnError != nil {
+		it.OnError(err)
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *JSONLinesIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.line == 0 && it.MaxLineSize > 0 {
+		it.src.MaxTokenSize = it.MaxLineSize
+	}
+
+	for it.src.Next() {
+		it.line += 1
+
+		b := it.src.Value()
+		if len(bytes.TrimSpace(b)) == 0 {
+			continue
+		}
+
+		var v T
+		if err := json.Unmarshal(b, &v); err != nil {
+			re := &RecordError{Line: it.line, Err: err}
+			if it.Policy == SkipOnError {
+				it.skip(re)
+				continue
+			}
+			it.err = re
+			return false
+		}
+		it.cur = v
+		return true
+	}
+
+	it.err = it.src.Err()
+	return false
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *JSONLinesIterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the error reading the input or the [RecordError] of
+// the line stopping the iterator, if any.
+func (it *JSONLinesIterator[T]) Err() error {
+	return it.err
+}
+
+// Iter returns the [JSONLinesIterator] as an [itkit.Iterator] value.
+func (it *JSONLinesIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// InJSONLines returns a [JSONLinesIterator] yielding values decoded
+// from the lines read from r.
+func InJSONLines[T any](r io.Reader) *JSONLinesIterator[T] {
+	src := ioit.Scan(r, ioit.ScanRawLines)
+	return &JSONLinesIterator[T]{src: src}
+}
+
+// ToJSONLines writes the items of the given iterator to w as JSON
+// values, one per line.
+//
+// ToJSONLines stops at the first error encoding or writing an item,
+// closing the iterator, and returns the error.  Otherwise, the error
+// reported by the iterator is returned, if any.
+func ToJSONLines[T any](w io.Writer, it itkit.Iterator[T]) error {
+	enc := json.NewEncoder(w)
+	for it.Next() {
+		if err := enc.Encode(it.Value()); err != nil {
+			_ = itkit.Close(it)
+			return err
+		}
+	}
+	return itkit.Err(it)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package combit
+
+import (
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// CombinationsIterator represents an iterator yielding all r-length
+// combinations of the items in a pool.
+//
+// Results are yielded in lexicographic order of the positions of
+// the items in the pool.  Items are treated as unique based on their
+// position, not their value.
+type CombinationsIterator[T any] struct {
+	selector[T]
+
+	r           int
+	replacement bool
+}
+
+// Ensure CombinationsIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[[]struct{}] = &CombinationsIterator[struct{}]{}
+
+func (it *CombinationsIterator[T]) first() bool {
+	n := len(it.pools[0])
+	switch {
+	case it.r < 0:
+		return false
+	case it.replacement && n == 0 && it.r > 0:
+		return false
+	case !it.replacement && it.r > n:
+		return false
+	}
+
+	it.indices = make([]int, it.r)
+	if !it.replacement {
+		for i := range it.indices {
+			it.indices[i] = i
+		}
+	}
+
+	it.emit(it.indices)
+	return true
+}
+
+func (it *CombinationsIterator[T]) next() bool {
+	n, r := len(it.pools[0]), it.r
+
+	// Find the rightmost index that can still be increased.
+	i := r - 1
+	for ; i >= 0; i-- {
+		if it.replacement && it.indices[i] != n-1 {
+			break
+		}
+		if !it.replacement && it.indices[i] != i+n-r {
+			break
+		}
+	}
+	if i < 0 {
+		return false
+	}
+
+	it.indices[i] += 1
+	for j := i + 1; j < r; j++ {
+		if it.replacement {
+			it.indices[j] = it.indices[i]
+		} else {
+			it.indices[j] = it.indices[j-1] + 1
+		}
+	}
+
+	it.emit(it.indices)
+	return true
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *CombinationsIterator[T]) Next() bool {
+	return it.step(it.first, it.next)
+}
+
+func newCombinations[T any](r int, pool []T, replacement bool) *CombinationsIterator[T] {
+	it := &CombinationsIterator[T]{r: r, replacement: replacement}
+	it.pools = repeat(pool, max(r, 1))
+	return it
+}
+
+// Combinations returns a new [CombinationsIterator] value yielding
+// all r-length combinations of the items in the given pool.
+func Combinations[T any](r int, pool []T) *CombinationsIterator[T] {
+	return newCombinations(r, pool, false)
+}
+
+// CombinationsI behaves like [Combinations], consuming the given
+// iterator once to retrieve the pool.
+func CombinationsI[T any](r int, it itkit.Iterator[T]) *CombinationsIterator[T] {
+	return Combinations(r, sliceit.To(it))
+}
+
+// CombinationsWithReplacement returns a new [CombinationsIterator]
+// value yielding all r-length combinations of the items in the given
+// pool allowing individual items to be repeated.
+func CombinationsWithReplacement[T any](r int, pool []T) *CombinationsIterator[T] {
+	return newCombinations(r, pool, true)
+}
+
+// CombinationsWithReplacementI behaves like
+// [CombinationsWithReplacement], consuming the given iterator once to
+// retrieve the pool.
+func CombinationsWithReplacementI[T any](r int, it itkit.Iterator[T]) *CombinationsIterator[T] {
+	return CombinationsWithReplacement(r, sliceit.To(it))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package combit
+
+// selector holds the state shared by all combinatoric iterators,
+// selecting one item from each pool by their indices.
+type selector[T any] struct {
+	// Reuse specifies whenever the same slice is yielded for every
+	// result, overwriting its contents on every call to Next.
+	Reuse bool
+
+	pools   [][]T
+	indices []int
+	cur     []T
+
+	started bool
+	done    bool
+}
+
+// Value returns the current selection.
+//
+// Note: The returned slice is overwritten by the next call to Next
+// if Reuse is set.
+func (s *selector[T]) Value() []T { return s.cur }
+
+// emit sets the current selection to the items in the pools at the
+// given indices.
+func (s *selector[T]) emit(indices []int) {
+	if !s.Reuse || s.cur == nil {
+		s.cur = make([]T, len(indices))
+	}
+	for i, j := range indices {
+		s.cur[i] = s.pools[i][j]
+	}
+}
+
+// step advances the selector calling the given function first once
+// to set up the first selection and the given function next for all
+// following selections.
+func (s *selector[T]) step(first, next func() bool) bool {
+	if s.done {
+		return false
+	}
+	if !s.started {
+		s.started = true
+		s.done = !first()
+	} else {
+		s.done = !next()
+	}
+	return !s.done
+}
+
+// repeat returns a slice holding the given pool n times.
+func repeat[T any](pool []T, n int) [][]T {
+	pools := make([][]T, max(n, 0))
+	for i := range pools {
+		pools[i] = pool
+	}
+	return pools
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package combit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/combit"
+	"github.com/0x5a17ed/itkit/iters/runeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// words joins the rune slices yielded by the given iterator to strings.
+func words(it itkit.Iterator[[]rune]) (out []string) {
+	for it.Next() {
+		out = append(out, string(it.Value()))
+	}
+	return
+}
+
+func fields(s string) []string {
+	if f := strings.Fields(s); len(f) > 0 {
+		return f
+	}
+	return nil
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name   string
+		it     itkit.Iterator[[]rune]
+		wanted string
+	}{
+		{"two", combit.Product(1, []rune("AB"), []rune("xy")), "Ax Ay Bx By"},
+		{"three", combit.Product(1, []rune("AB"), []rune("x"), []rune("12")), "Ax1 Ax2 Bx1 Bx2"},
+		{"repeat", combit.Product(2, []rune("01")), "00 01 10 11"},
+		{"repeat-two", combit.Product(2, []rune("A"), []rune("01")), "A0A0 A0A1 A1A0 A1A1"},
+		{"empty pool", combit.Product(1, []rune("AB"), []rune("")), ""},
+		{"iterators", combit.ProductI(1, runeit.InString("AB"), runeit.InString("c")), "Ac Bc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, fields(tt.wanted), words(tt.it))
+		})
+	}
+
+	t.Run("nothing", func(t *testing.T) {
+		// The product of no pools is a single empty selection.
+		assert.Equal(t, [][]int{{}}, sliceit.To[[]int](combit.Product[int](1)))
+		assert.Equal(t, [][]int{{}}, sliceit.To[[]int](combit.Product(0, []int{1, 2})))
+	})
+}
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		name   string
+		it     itkit.Iterator[[]rune]
+		wanted string
+	}{
+		{"r2", combit.Permutations(2, []rune("ABCD")),
+			"AB AC AD BA BC BD CA CB CD DA DB DC"},
+		{"full", combit.Permutations(3, []rune("012")), "012 021 102 120 201 210"},
+		{"too long", combit.Permutations(4, []rune("012")), ""},
+		{"negative", combit.Permutations(-1, []rune("012")), ""},
+		{"iterator", combit.PermutationsI(2, runeit.InString("AB")), "AB BA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, fields(tt.wanted), words(tt.it))
+		})
+	}
+
+	t.Run("zero", func(t *testing.T) {
+		assert.Equal(t, [][]int{{}}, sliceit.To[[]int](combit.Permutations(0, []int{1, 2})))
+	})
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		name   string
+		it     itkit.Iterator[[]rune]
+		wanted string
+	}{
+		{"r2", combit.Combinations(2, []rune("ABCD")), "AB AC AD BC BD CD"},
+		{"r3", combit.Combinations(3, []rune("0123")), "012 013 023 123"},
+		{"full", combit.Combinations(3, []rune("012")), "012"},
+		{"too long", combit.Combinations(4, []rune("012")), ""},
+		{"iterator", combit.CombinationsI(2, runeit.InString("ABC")), "AB AC BC"},
+
+		{"replacement", combit.CombinationsWithReplacement(2, []rune("ABC")),
+			"AA AB AC BB BC CC"},
+		{"replacement long", combit.CombinationsWithReplacement(3, []rune("AB")),
+			"AAA AAB ABB BBB"},
+		{"replacement empty", combit.CombinationsWithReplacement(2, []rune("")), ""},
+		{"replacement iterator", combit.CombinationsWithReplacementI(2, runeit.InString("AB")),
+			"AA AB BB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, fields(tt.wanted), words(tt.it))
+		})
+	}
+}
+
+func TestReuse(t *testing.T) {
+	asserter := assert.New(t)
+
+	it := combit.Combinations(2, []int{1, 2, 3})
+	it.Reuse = true
+
+	var first []int
+	var n int
+	for ; it.Next(); n++ {
+		if first == nil {
+			first = it.Value()
+		}
+		asserter.Same(&first[0], &it.Value()[0])
+	}
+	asserter.Equal(3, n)
+
+	// The reused slice holds the last result.
+	asserter.Equal([]int{2, 3}, first)
+
+	// Without reuse every result is a separate slice.
+	asserter.Equal([][]int{{1, 2}, {1, 3}, {2, 3}}, sliceit.To[[]int](combit.Combinations(2, []int{1, 2, 3})))
+}
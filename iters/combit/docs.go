@@ -0,0 +1,28 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package combit provides iterators yielding combinatoric selections
+// of items in lexicographic order, similar to Python's itertools.
+//
+// Iterator functions:
+//   - [Product], [ProductI] - yields the cartesian product of pools
+//   - [Permutations], [PermutationsI] - yields r-length permutations
+//   - [Combinations], [CombinationsI] - yields r-length combinations
+//   - [CombinationsWithReplacement], [CombinationsWithReplacementI] -
+//     yields r-length combinations allowing items to be repeated
+//
+// All iterators yield slices, a new slice for every result by default.
+// Setting the Reuse field of an iterator makes it overwrite and yield
+// the same slice for every result instead.
+package combit
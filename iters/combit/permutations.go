@@ -0,0 +1,93 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package combit
+
+import (
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// PermutationsIterator represents an iterator yielding all r-length
+// permutations of the items in a pool.
+//
+// Results are yielded in lexicographic order of the positions of
+// the items in the pool.  Items are treated as unique based on their
+// position, not their value.
+type PermutationsIterator[T any] struct {
+	selector[T]
+
+	r      int
+	cycles []int
+}
+
+// Ensure PermutationsIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[[]struct{}] = &PermutationsIterator[struct{}]{}
+
+func (it *PermutationsIterator[T]) first() bool {
+	n := len(it.pools[0])
+	if it.r < 0 || it.r > n {
+		return false
+	}
+
+	it.indices = make([]int, n)
+	for i := range it.indices {
+		it.indices[i] = i
+	}
+	it.cycles = make([]int, it.r)
+	for i := range it.cycles {
+		it.cycles[i] = n - i
+	}
+
+	it.emit(it.indices[:it.r])
+	return true
+}
+
+func (it *PermutationsIterator[T]) next() bool {
+	n := len(it.indices)
+	for i := it.r - 1; i >= 0; i-- {
+		if it.cycles[i] -= 1; it.cycles[i] > 0 {
+			j := n - it.cycles[i]
+			it.indices[i], it.indices[j] = it.indices[j], it.indices[i]
+			it.emit(it.indices[:it.r])
+			return true
+		}
+
+		// Rotate the index at i to the end.
+		idx := it.indices[i]
+		copy(it.indices[i:], it.indices[i+1:])
+		it.indices[n-1] = idx
+		it.cycles[i] = n - i
+	}
+	return false
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *PermutationsIterator[T]) Next() bool {
+	return it.step(it.first, it.next)
+}
+
+// Permutations returns a new [PermutationsIterator] value yielding
+// all r-length permutations of the items in the given pool.
+func Permutations[T any](r int, pool []T) *PermutationsIterator[T] {
+	it := &PermutationsIterator[T]{r: r}
+	it.pools = repeat(pool, max(r, 1))
+	return it
+}
+
+// PermutationsI behaves like [Permutations], consuming the given
+// iterator once to retrieve the pool.
+func PermutationsI[T any](r int, it itkit.Iterator[T]) *PermutationsIterator[T] {
+	return Permutations(r, sliceit.To(it))
+}
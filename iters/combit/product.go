@@ -0,0 +1,81 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package combit
+
+import (
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// ProductIterator represents an iterator yielding the cartesian
+// product of multiple pools of items.
+//
+// Results are yielded in lexicographic order of the pools, the
+// rightmost item advancing on every iteration like an odometer.
+type ProductIterator[T any] struct {
+	selector[T]
+}
+
+// Ensure ProductIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[[]struct{}] = &ProductIterator[struct{}]{}
+
+func (it *ProductIterator[T]) first() bool {
+	for _, pool := range it.pools {
+		if len(pool) == 0 {
+			return false
+		}
+	}
+	it.indices = make([]int, len(it.pools))
+	it.emit(it.indices)
+	return true
+}
+
+func (it *ProductIterator[T]) next() bool {
+	for i := len(it.indices) - 1; i >= 0; i-- {
+		if it.indices[i] += 1; it.indices[i] < len(it.pools[i]) {
+			it.emit(it.indices)
+			return true
+		}
+		it.indices[i] = 0
+	}
+	return false
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ProductIterator[T]) Next() bool {
+	return it.step(it.first, it.next)
+}
+
+// Product returns a new [ProductIterator] value yielding the
+// cartesian product of the given pools repeated n times.
+//
+// Product(2, a, b) yields the same results as Product(1, a, b, a, b).
+func Product[T any](n int, pools ...[]T) *ProductIterator[T] {
+	it := &ProductIterator[T]{}
+	for i := 0; i < n; i++ {
+		it.pools = append(it.pools, pools...)
+	}
+	return it
+}
+
+// ProductI behaves like [Product], consuming each given iterator
+// once to retrieve the pools.
+func ProductI[T any](n int, iters ...itkit.Iterator[T]) *ProductIterator[T] {
+	pools := make([][]T, len(iters))
+	for i, it := range iters {
+		pools[i] = sliceit.To(it)
+	}
+	return Product(n, pools...)
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsit
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// DefaultBatchSize is the number of directory entries read at once
+// if not specified otherwise.
+const DefaultBatchSize = 128
+
+// Order specifies the order a file tree is walked in.
+type Order int
+
+const (
+	// DepthFirst yields the entries of a directory right after
+	// the directory itself.
+	DepthFirst Order = iota
+
+	// BreadthFirst yields the entries of a directory once all
+	// entries closer to the root have been yielded.
+	BreadthFirst
+)
+
+// Entry is a [fs.DirEntry] yielded by a [WalkIterator].
+type Entry struct {
+	fs.DirEntry
+
+	// Path is the path of the entry, starting with the root
+	// path given to the [WalkIterator].
+	Path string
+
+	// Depth is the number of directories between the entry and
+	// the root, the root itself being at depth 0.
+	Depth int
+}
+
+// ErrorFn is called with the path of a directory failing to be read
+// and the error.  Returning nil skips the directory, returning an
+// error stops the iterator with that error.
+type ErrorFn func(path string, err error) error
+
+// dirReader reads the entries of a single directory incrementally.
+type dirReader struct {
+	path  string
+	depth int
+	f     fs.ReadDirFile
+	buf   []fs.DirEntry
+	done  bool
+}
+
+func (d *dirReader) open(fsys fs.FS) error {
+	f, err := fsys.Open(d.path)
+	if err != nil {
+		return err
+	}
+	if rdf, ok := f.(fs.ReadDirFile); ok {
+		d.f = rdf
+		return nil
+	}
+
+	// Fall back to reading the whole directory at once.
+	_ = f.Close()
+	d.buf, err = fs.ReadDir(fsys, d.path)
+	d.done = true
+	return err
+}
+
+func (d *dirReader) next(fsys fs.FS, n int) (e fs.DirEntry, ok bool, err error) {
+	for len(d.buf) == 0 {
+		switch {
+		case d.done:
+			return nil, false, nil
+		case d.f == nil:
+			if err = d.open(fsys); err != nil {
+				return nil, false, err
+			}
+			continue
+		}
+
+		d.buf, err = d.f.ReadDir(n)
+		if errors.Is(err, io.EOF) {
+			d.done = true
+		} else if err != nil {
+			return nil, false, err
+		}
+	}
+
+	e, d.buf = d.buf[0], d.buf[1:]
+	return e, true, nil
+}
+
+func (d *dirReader) Close() error {
+	d.done, d.buf = true, nil
+	if d.f == nil {
+		return nil
+	}
+	f := d.f
+	d.f = nil
+	return f.Close()
+}
+
+// WalkIterator yields the entries of a file tree rooted at a given
+// directory, including the root itself, reading directories lazily
+// as the tree is walked.
+//
+// The entries of a directory are yielded in the order the
+// [fs.ReadDirFile] returns them, which is not necessarily sorted.
+//
+// The configuration fields must not be changed once the first item
+// has been retrieved from the iterator.
+type WalkIterator struct {
+	// Order specifies the order the tree is walked in.
+	Order Order
+
+	// MaxDepth limits the depth of the yielded entries, the
+	// depth is not limited if MaxDepth is not positive.
+	MaxDepth int
+
+	// Pattern filters the yielded entries by matching their name
+	// against the pattern with [path.Match].  Directories not
+	// matching the pattern are walked nonetheless.
+	Pattern string
+
+	// BatchSize specifies the number of entries read from a
+	// directory at once, defaulting to [DefaultBatchSize].
+	BatchSize int
+
+	// OnError is called for directories failing to be read,
+	// stopping the iterator if nil.
+	OnError ErrorFn
+
+	fsys fs.FS
+	root string
+
+	started bool
+	dirs    []*dirReader
+	pending *Entry
+	pruned  bool
+	cur     Entry
+	err     error
+}
+
+// Ensure WalkIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[Entry] = &WalkIterator{}
+
+func (it *WalkIterator) batchSize() int {
+	if it.BatchSize > 0 {
+		return it.BatchSize
+	}
+	return DefaultBatchSize
+}
+
+func (it *WalkIterator) match(e Entry) (bool, error) {
+	if it.Pattern == "" {
+		return true, nil
+	}
+	return path.Match(it.Pattern, e.Name())
+}
+
+// descend schedules the directory yielded last to be walked unless
+// it has been pruned or is too deep.
+func (it *WalkIterator) descend() {
+	e := it.pending
+	if e == nil {
+		return
+	}
+	it.pending = nil
+	if it.pruned || (it.MaxDepth > 0 && e.Depth >= it.MaxDepth) {
+		return
+	}
+	it.dirs = append(it.dirs, &dirReader{path: e.Path, depth: e.Depth})
+}
+
+// current returns the directory to read the next entry from.
+func (it *WalkIterator) current() *dirReader {
+	switch {
+	case len(it.dirs) == 0:
+		return nil
+	case it.Order == BreadthFirst:
+		return it.dirs[0]
+	}
+	return it.dirs[len(it.dirs)-1]
+}
+
+// drop closes and removes the current directory.
+func (it *WalkIterator) drop() {
+	_ = it.current().Close()
+	if it.Order == BreadthFirst {
+		it.dirs[0], it.dirs = nil, it.dirs[1:]
+	} else {
+		it.dirs[len(it.dirs)-1], it.dirs = nil, it.dirs[:len(it.dirs)-1]
+	}
+}
+
+func (it *WalkIterator) start() (e Entry, err error) {
+	it.started = true
+
+	info, err := fs.Stat(it.fsys, it.root)
+	if err != nil {
+		return e, err
+	}
+	return Entry{DirEntry: fs.FileInfoToDirEntry(info), Path: it.root}, nil
+}
+
+// read returns the next entry of the tree.
+func (it *WalkIterator) read() (e Entry, ok bool, err error) {
+	if !it.started {
+		e, err = it.start()
+		return e, err == nil, err
+	}
+
+	for d := it.current(); d != nil; d = it.current() {
+		de, ok, err := d.next(it.fsys, it.batchSize())
+		if err != nil {
+			if it.OnError == nil {
+				return e, false, err
+			}
+			if err = it.OnError(d.path, err); err != nil {
+				return e, false, err
+			}
+		}
+		if !ok {
+			it.drop()
+			continue
+		}
+		return Entry{DirEntry: de, Path: path.Join(d.path, de.Name()), Depth: d.depth + 1}, true, nil
+	}
+	return e, false, nil
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *WalkIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for {
+		it.descend()
+		it.pruned = false
+
+		e, ok, err := it.read()
+		if err == nil && ok {
+			if e.IsDir() {
+				it.pending = &e
+			}
+			ok, err = it.match(e)
+			if err == nil && !ok {
+				continue
+			}
+		}
+		if err != nil {
+			it.err = err
+			_ = it.Close()
+		}
+		if !ok {
+			return false
+		}
+
+		it.cur = e
+		return true
+	}
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *WalkIterator) Value() Entry {
+	return it.cur
+}
+
+// Err returns the error stopping the iterator, if any.
+func (it *WalkIterator) Err() error {
+	return it.err
+}
+
+// Prune prevents the directory yielded last from being walked.  It
+// has no effect if the entry yielded last is not a directory.
+func (it *WalkIterator) Prune() {
+	it.pruned = true
+}
+
+// Close closes all directories being read, implementing the
+// [io.Closer] interface.  The iterator yields no more items once
+// closed.
+func (it *WalkIterator) Close() (err error) {
+	for _, d := range it.dirs {
+		err = errors.Join(err, d.Close())
+	}
+	it.dirs, it.pending, it.started = nil, nil, true
+	return err
+}
+
+// Iter returns the [WalkIterator] as an [itkit.Iterator] value.
+func (it *WalkIterator) Iter() itkit.Iterator[Entry] {
+	return it
+}
+
+// Walk returns a [WalkIterator] yielding the entries of the file
+// tree rooted at root in fsys in depth-first order.
+func Walk(fsys fs.FS, root string) *WalkIterator {
+	return &WalkIterator{fsys: fsys, root: root}
+}
+
+// WalkBreadthFirst returns a [WalkIterator] yielding the entries of
+// the file tree rooted at root in fsys in breadth-first order.
+func WalkBreadthFirst(fsys fs.FS, root string) *WalkIterator {
+	return &WalkIterator{Order: BreadthFirst, fsys: fsys, root: root}
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsit_test
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/fsit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+var tree = fstest.MapFS{
+	"a/x.go":     {},
+	"a/b/y.go":   {},
+	"a/b/z.txt":  {},
+	"a/c/d/w.go": {},
+	"e.txt":      {},
+}
+
+func paths(it *fsit.WalkIterator) []string {
+	return sliceit.To(itlib.Map(it.Iter(), func(e fsit.Entry) string { return e.Path }))
+}
+
+// trackingFS counts the files opened but not closed yet and fails
+// to open the paths in broken.
+type trackingFS struct {
+	fs.FS
+	open   int
+	broken map[string]bool
+}
+
+type trackedFile struct {
+	fs.ReadDirFile
+	fsys *trackingFS
+}
+
+func (f trackedFile) Close() error { f.fsys.open -= 1; return f.ReadDirFile.Close() }
+
+func (t *trackingFS) Open(name string) (fs.File, error) {
+	if t.broken[name] {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: ittest.ErrBroken}
+	}
+	f, err := t.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if rdf, ok := f.(fs.ReadDirFile); ok {
+		t.open += 1
+		return trackedFile{ReadDirFile: rdf, fsys: t}, nil
+	}
+	return f, nil
+}
+
+func TestWalk(t *testing.T) {
+	tt := []struct {
+		name   string
+		fn     func() *fsit.WalkIterator
+		wanted []string
+	}{
+		{"depth first", func() *fsit.WalkIterator {
+			return fsit.Walk(tree, ".")
+		}, []string{".", "a", "a/b", "a/b/y.go", "a/b/z.txt", "a/c", "a/c/d", "a/c/d/w.go", "a/x.go", "e.txt"}},
+		{"breadth first", func() *fsit.WalkIterator {
+			return fsit.WalkBreadthFirst(tree, ".")
+		}, []string{".", "a", "e.txt", "a/b", "a/c", "a/x.go", "a/b/y.go", "a/b/z.txt", "a/c/d", "a/c/d/w.go"}},
+		{"subtree", func() *fsit.WalkIterator {
+			return fsit.Walk(tree, "a/b")
+		}, []string{"a/b", "a/b/y.go", "a/b/z.txt"}},
+		{"file", func() *fsit.WalkIterator {
+			return fsit.Walk(tree, "e.txt")
+		}, []string{"e.txt"}},
+		{"max depth", func() *fsit.WalkIterator {
+			it := fsit.Walk(tree, ".")
+			it.MaxDepth = 2
+			return it
+		}, []string{".", "a", "a/b", "a/c", "a/x.go", "e.txt"}},
+		{"pattern", func() *fsit.WalkIterator {
+			it := fsit.Walk(tree, ".")
+			it.Pattern = "*.go"
+			return it
+		}, []string{"a/b/y.go", "a/c/d/w.go", "a/x.go"}},
+		{"batch size", func() *fsit.WalkIterator {
+			it := fsit.WalkBreadthFirst(tree, "a")
+			it.BatchSize = 1
+			return it
+		}, []string{"a", "a/b", "a/c", "a/x.go", "a/b/y.go", "a/b/z.txt", "a/c/d", "a/c/d/w.go"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			it := tc.fn()
+			assert.Equal(t, tc.wanted, paths(it))
+			assert.NoError(t, it.Err())
+		})
+	}
+}
+
+func TestWalk_Depth(t *testing.T) {
+	depths := map[string]int{}
+	for it := fsit.Walk(tree, "a"); it.Next(); {
+		depths[it.Value().Path] = it.Value().Depth
+	}
+	assert.Equal(t, 0, depths["a"])
+	assert.Equal(t, 1, depths["a/c"])
+	assert.Equal(t, 3, depths["a/c/d/w.go"])
+}
+
+func TestWalk_Prune(t *testing.T) {
+	var s []string
+	for it := fsit.Walk(tree, "."); it.Next(); {
+		s = append(s, it.Value().Path)
+		if it.Value().Name() == "b" || it.Value().Name() == "x.go" {
+			it.Prune()
+		}
+	}
+	assert.Equal(t, []string{".", "a", "a/b", "a/c", "a/c/d", "a/c/d/w.go", "a/x.go", "e.txt"}, s)
+}
+
+func TestWalk_Err(t *testing.T) {
+	t.Run("root", func(t *testing.T) {
+		it := fsit.Walk(tree, "missing")
+		assert.False(t, it.Next())
+		assert.ErrorIs(t, it.Err(), fs.ErrNotExist)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		fsys := &trackingFS{FS: tree, broken: map[string]bool{"a/c": true}}
+		it := fsit.Walk(fsys, ".")
+
+		assert.Equal(t, []string{".", "a", "a/b", "a/b/y.go", "a/b/z.txt", "a/c"}, paths(it))
+		assert.ErrorIs(t, it.Err(), ittest.ErrBroken)
+		assert.Equal(t, 0, fsys.open)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		fsys := &trackingFS{FS: tree, broken: map[string]bool{"a/c": true}}
+		it := fsit.Walk(fsys, ".")
+
+		var skipped []string
+		it.OnError = func(path string, err error) error {
+			skipped = append(skipped, path)
+			return nil
+		}
+
+		assert.Equal(t, []string{".", "a", "a/b", "a/b/y.go", "a/b/z.txt", "a/c", "a/x.go", "e.txt"}, paths(it))
+		assert.NoError(t, it.Err())
+		assert.Equal(t, []string{"a/c"}, skipped)
+	})
+
+	t.Run("pattern", func(t *testing.T) {
+		it := fsit.Walk(tree, ".")
+		it.Pattern = "["
+		assert.False(t, it.Next())
+		assert.ErrorIs(t, it.Err(), path.ErrBadPattern)
+	})
+}
+
+func TestWalk_Close(t *testing.T) {
+	fsys := &trackingFS{FS: tree}
+	it := fsit.Walk(fsys, ".")
+
+	for it.Next() && it.Value().Path != "a/c/d/w.go" {
+	}
+	assert.Equal(t, 4, fsys.open)
+
+	assert.NoError(t, it.Close())
+	assert.Equal(t, 0, fsys.open)
+	assert.False(t, it.Next())
+}
+
+func TestWalk_DirFS(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a/b", "a/c", "d"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0o755))
+	}
+	for i := 0; i < 10; i++ {
+		name := filepath.Join(dir, "a", "b", string(rune('0'+i)))
+		assert.NoError(t, os.WriteFile(name, nil, 0o644))
+	}
+
+	it := fsit.Walk(os.DirFS(dir), ".")
+	it.BatchSize = 3
+
+	s := paths(it)
+	assert.NoError(t, it.Err())
+	sort.Strings(s)
+	assert.Equal(t, []string{
+		".", "a", "a/b",
+		"a/b/0", "a/b/1", "a/b/2", "a/b/3", "a/b/4", "a/b/5", "a/b/6", "a/b/7", "a/b/8", "a/b/9",
+		"a/c", "d",
+	}, s)
+}
@@ -20,4 +20,9 @@
 //     data and retrieving it
 //   - [PullFn] - calls a single [PullFnT] function to test for more data and
 //     retrieving it
+//   - [PullErrFn] - like [PullFn], calling a [PullErrFnT] function which
+//     can fail
+//   - [Retry] - wraps a [PullErrFnT] function retrying failed calls
+//   - [Paginate] - yields the items of all pages fetched by a
+//     [PageFetchFnT] function following continuation tokens
 package funcit
@@ -0,0 +1,200 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcit
+
+import (
+	"context"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// PageFetchFnT represents a function fetching the page identified by
+// the given continuation token, returning the items of the page and
+// the token of the next page.  The first page is fetched with the
+// zero token and a zero next token marks the last page.
+type PageFetchFnT[T any, C comparable] func(ctx context.Context, token C) (items []T, next C, err error)
+
+type pageResult[T any, C comparable] struct {
+	items []T
+	next  C
+	err   error
+}
+
+// PageIterator represents an iterator yielding the items of all pages
+// fetched by a [PageFetchFnT] function one page after another.
+//
+// With Prefetch enabled, the next page is fetched on a separate
+// goroutine while the items of the current page are consumed.  An
+// iterator abandoned before being exhausted must be closed with
+// [PageIterator.Close] to stop fetching.
+//
+// The configuration fields must not be changed once the first item
+// has been retrieved from the iterator.
+type PageIterator[T any, C comparable] struct {
+	// Prefetch specifies whenever the next page is fetched in
+	// the background.
+	Prefetch bool
+
+	// MaxPages limits the number of pages fetched, not limited
+	// if not positive.
+	MaxPages int
+
+	// MaxItems limits the number of items yielded, not limited
+	// if not positive.
+	MaxItems int
+
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+	fetch  PageFetchFnT[T, C]
+
+	started bool
+	done    bool
+	token   C
+	pages   int
+	items   int
+	page    []T
+	pos     int
+	pending chan pageResult[T, C]
+
+	cur T
+	err error
+}
+
+// Ensure PageIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &PageIterator[struct{}, string]{}
+
+func (it *PageIterator[T, C]) start() {
+	it.started = true
+	if it.parent == nil {
+		it.parent = context.Background()
+	}
+	it.ctx, it.cancel = context.WithCancel(it.parent)
+}
+
+// more reports whenever there are more pages to fetch.
+func (it *PageIterator[T, C]) more() bool {
+	var zero C
+	return (it.pages == 0 || it.token != zero) && (it.MaxPages <= 0 || it.pages < it.MaxPages)
+}
+
+// filled reports whenever the current page suffices to reach
+// MaxItems.
+func (it *PageIterator[T, C]) filled() bool {
+	return it.MaxItems > 0 && it.items+len(it.page) >= it.MaxItems
+}
+
+func (it *PageIterator[T, C]) prefetch() {
+	ch := make(chan pageResult[T, C], 1)
+	it.pending, it.pages = ch, it.pages+1
+
+	go func(ctx context.Context, token C) {
+		items, next, err := it.fetch(ctx, token)
+		ch <- pageResult[T, C]{items: items, next: next, err: err}
+	}(it.ctx, it.token)
+}
+
+// nextPage replaces the current page with the next page, reporting
+// whenever there was a next page.
+func (it *PageIterator[T, C]) nextPage() bool {
+	var r pageResult[T, C]
+	switch {
+	case it.pending != nil:
+		r, it.pending = <-it.pending, nil
+	case it.more():
+		it.pages += 1
+		r.items, r.next, r.err = it.fetch(it.ctx, it.token)
+	default:
+		return false
+	}
+
+	if r.err != nil {
+		it.err = r.err
+		return false
+	}
+	it.page, it.pos, it.token = r.items, 0, r.next
+
+	if it.Prefetch && it.more() && !it.filled() {
+		it.prefetch()
+	}
+	return true
+}
+
+// stop stops fetching pages, waiting for a page being prefetched.
+func (it *PageIterator[T, C]) stop() {
+	it.done, it.page = true, nil
+	if it.cancel != nil {
+		it.cancel()
+	}
+	if it.pending != nil {
+		<-it.pending
+		it.pending = nil
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *PageIterator[T, C]) Next() bool {
+	if it.done {
+		return false
+	}
+	if !it.started {
+		it.start()
+	}
+
+	if it.MaxItems > 0 && it.items >= it.MaxItems {
+		it.stop()
+		return false
+	}
+	for it.pos >= len(it.page) {
+		if !it.nextPage() {
+			it.stop()
+			return false
+		}
+	}
+
+	it.cur, it.pos, it.items = it.page[it.pos], it.pos+1, it.items+1
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *PageIterator[T, C]) Value() T {
+	return it.cur
+}
+
+// Err returns the error returned by the [PageFetchFnT] function, if
+// any.
+func (it *PageIterator[T, C]) Err() error {
+	return it.err
+}
+
+// Close stops fetching pages, waiting for a page being prefetched,
+// implementing the [io.Closer] interface.
+func (it *PageIterator[T, C]) Close() error {
+	it.stop()
+	return nil
+}
+
+// Iter returns the [PageIterator] as an [itkit.Iterator] value.
+func (it *PageIterator[T, C]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// Paginate provides an iterator yielding the items of all pages
+// fetched by the given [PageFetchFnT] function, starting with the
+// first page and following the continuation tokens until the last
+// page.  A nil context defaults to [context.Background].
+func Paginate[T any, C comparable](ctx context.Context, fetch PageFetchFnT[T, C]) *PageIterator[T, C] {
+	return &PageIterator[T, C]{parent: ctx, fetch: fetch}
+}
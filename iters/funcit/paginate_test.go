@@ -0,0 +1,161 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcit
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	assertpkg "github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// pagedFetchFn returns a function serving the given pages with the
+// page index as continuation token, reporting each fetched token
+// on the returned channel.
+func pagedFetchFn(pages ...[]int) (fn PageFetchFnT[int, string], fetched chan string) {
+	fetched = make(chan string, len(pages)+1)
+	return func(ctx context.Context, token string) ([]int, string, error) {
+		fetched <- token
+
+		i := 0
+		if token != "" {
+			i, _ = strconv.Atoi(token)
+		}
+		if i >= len(pages) {
+			return nil, "", errFatal
+		}
+
+		var next string
+		if i+1 < len(pages) {
+			next = strconv.Itoa(i + 1)
+		}
+		return pages[i], next, nil
+	}, fetched
+}
+
+func TestPaginate(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	for _, prefetch := range []bool{false, true} {
+		fn, fetched := pagedFetchFn([]int{1, 2}, nil, []int{3}, []int{4, 5})
+
+		it := Paginate(context.Background(), fn)
+		it.Prefetch = prefetch
+
+		s, err := sliceit.ToErr[int](it)
+		assertpkg.NoError(t, err)
+		assertpkg.Equal(t, []int{1, 2, 3, 4, 5}, s)
+		assertpkg.False(t, it.Next())
+		assertpkg.Len(t, fetched, 4)
+	}
+}
+
+func TestPaginate_NilContext(t *testing.T) {
+	fn, _ := pagedFetchFn([]int{1, 2})
+
+	s, err := sliceit.ToErr[int](Paginate(nil, fn))
+	assertpkg.NoError(t, err)
+	assertpkg.Equal(t, []int{1, 2}, s)
+}
+
+func TestPaginate_Limits(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	for _, prefetch := range []bool{false, true} {
+		fn, fetched := pagedFetchFn([]int{1, 2}, []int{3}, []int{4, 5})
+
+		it := Paginate(context.Background(), fn)
+		it.Prefetch, it.MaxPages = prefetch, 2
+
+		s, err := sliceit.ToErr[int](it)
+		assertpkg.NoError(t, err)
+		assertpkg.Equal(t, []int{1, 2, 3}, s)
+		assertpkg.Len(t, fetched, 2)
+
+		fn, fetched = pagedFetchFn([]int{1, 2}, []int{3}, []int{4, 5})
+
+		it = Paginate(context.Background(), fn)
+		it.Prefetch, it.MaxItems = prefetch, 2
+
+		s, err = sliceit.ToErr[int](it)
+		assertpkg.NoError(t, err)
+		assertpkg.Equal(t, []int{1, 2}, s)
+		assertpkg.Len(t, fetched, 1)
+
+		fn, fetched = pagedFetchFn([]int{1, 2}, []int{3}, []int{4, 5})
+
+		it = Paginate(context.Background(), fn)
+		it.Prefetch, it.MaxItems = prefetch, 3
+
+		s, err = sliceit.ToErr[int](it)
+		assertpkg.NoError(t, err)
+		assertpkg.Equal(t, []int{1, 2, 3}, s)
+		assertpkg.Len(t, fetched, 2)
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	fn := func(ctx context.Context, token int) ([]int, int, error) {
+		if token == 0 {
+			return []int{1, 2}, 1, nil
+		}
+		return nil, 0, errFatal
+	}
+
+	for _, prefetch := range []bool{false, true} {
+		it := Paginate(context.Background(), fn)
+		it.Prefetch = prefetch
+
+		s, err := sliceit.ToErr[int](it)
+		assertpkg.ErrorIs(t, err, errFatal)
+		assertpkg.Equal(t, []int{1, 2}, s)
+		assertpkg.False(t, it.Next())
+	}
+}
+
+func TestPaginate_Prefetch(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	fetched := make(chan int, 2)
+	fn := func(ctx context.Context, token int) ([]int, int, error) {
+		fetched <- token
+		if token == 0 {
+			return []int{1}, 1, nil
+		}
+		<-ctx.Done()
+		return nil, 0, ctx.Err()
+	}
+
+	it := Paginate(context.Background(), fn)
+	it.Prefetch = true
+
+	assertpkg.True(t, it.Next())
+	assertpkg.Equal(t, 1, it.Value())
+	assertpkg.Equal(t, 0, <-fetched)
+
+	// The second page is requested before the first one is consumed.
+	assertpkg.Equal(t, 1, <-fetched)
+
+	// Closing cancels the pending request and waits for it.
+	assertpkg.NoError(t, it.Close())
+	assertpkg.False(t, it.Next())
+	assertpkg.NoError(t, it.Err())
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcit
+
+import (
+	"github.com/0x5a17ed/itkit"
+)
+
+// PullErrFnT represents a function that returns a single value, a
+// boolean value indicating whenever calling the function will yield
+// more values or not, and an error if retrieving the value failed.
+type PullErrFnT[T any] func() (T, bool, error)
+
+// PullErrIterator represents an iterator which calls a function until
+// it returns no more items or fails.
+type PullErrIterator[T any] struct {
+	fn  PullErrFnT[T]
+	cur T
+	err error
+}
+
+// Ensure PullErrIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &PullErrIterator[struct{}]{}
+
+func (it *PullErrIterator[T]) Value() T { return it.cur }
+
+func (it *PullErrIterator[T]) Next() (ok bool) {
+	if it.err != nil {
+		return false
+	}
+
+	var v T
+	if v, ok, it.err = it.fn(); it.err != nil {
+		return false
+	}
+	if ok {
+		it.cur = v
+	}
+	return ok
+}
+
+// Err returns the error returned by the function, if any.
+func (it *PullErrIterator[T]) Err() error { return it.err }
+
+// PullErrFn provides an iterator which calls the given function
+// returning its items until the function signals there are no more
+// items left or returns an error.
+func PullErrFn[T any](puller PullErrFnT[T]) itkit.Iterator[T] {
+	return &PullErrIterator[T]{fn: puller}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/0x5a17ed/itkit/itclock"
+)
+
+// ErrRetriesExhausted is reported once a function retried by
+// [Retry] failed on every attempt.
+var ErrRetriesExhausted = errors.New("funcit: retries exhausted")
+
+const (
+	// DefaultMaxAttempts is the number of attempts of a
+	// [RetryPolicy] not specifying any.
+	DefaultMaxAttempts = 3
+
+	// DefaultInitialDelay is the delay before the first retry of
+	// a [RetryPolicy] not specifying any.
+	DefaultInitialDelay = 100 * time.Millisecond
+)
+
+// RetryPolicy specifies how often and when failing calls are retried.
+//
+// The delay between attempts grows exponentially, starting with
+// InitialDelay and growing by Multiplier up to MaxDelay.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of calls per item,
+	// including the first one, defaulting to [DefaultMaxAttempts]
+	// if not positive.
+	MaxAttempts int
+
+	// InitialDelay is the delay before the first retry,
+	// defaulting to [DefaultInitialDelay] if not positive.
+	InitialDelay time.Duration
+
+	// MaxDelay caps the delay between attempts, not capped if not
+	// positive.
+	MaxDelay time.Duration
+
+	// Multiplier is the factor the delay grows by with every
+	// retry, defaulting to 2 if less than 1.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay randomly subtracted
+	// from it to spread retries, between 0 and 1.
+	Jitter float64
+
+	// Retryable reports whenever a call failing with the given
+	// error should be retried, all errors are retried if nil.
+	Retryable func(err error) bool
+
+	// Sleep is used to wait between attempts, defaulting to
+	// [itclock.Sleep] on [itclock.Real].
+	Sleep itclock.SleepFn
+
+	// Rand returns random numbers in [0, 1) to apply the Jitter,
+	// defaulting to [rand.Float64].
+	Rand func() float64
+}
+
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts > 0 {
+		return p.MaxAttempts
+	}
+	return DefaultMaxAttempts
+}
+
+// Delay returns the delay before the given retry, the first retry
+// being retry 1.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	initial, mult := p.InitialDelay, p.Multiplier
+	if initial <= 0 {
+		initial = DefaultInitialDelay
+	}
+	if mult < 1 {
+		mult = 2
+	}
+
+	d := float64(initial) * math.Pow(mult, float64(max(retry, 1)-1))
+	if p.MaxDelay > 0 {
+		d = min(d, float64(p.MaxDelay))
+	}
+	d = min(d, math.MaxInt64)
+
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		r := rand.Float64
+		if p.Rand != nil {
+			r = p.Rand
+		}
+		d -= d * jitter * r()
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63, which overflows
+	// when converted back.
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+func (p RetryPolicy) sleep(ctx context.Context, d time.Duration) error {
+	if p.Sleep != nil {
+		return p.Sleep(ctx, d)
+	}
+	return itclock.Sleep(ctx, itclock.Real, d)
+}
+
+// Retry returns a [PullErrFnT] calling the given function fn, calling
+// it again after a delay as specified by the given [RetryPolicy] p
+// as long as it fails with a retryable error.
+//
+// Errors not retryable are returned as is.  Once all attempts failed,
+// the last error is returned wrapped together with
+// [ErrRetriesExhausted].  Calling fn and waiting between attempts
+// stops once the given context is done, returning the error of the
+// context.
+func Retry[T any](ctx context.Context, p RetryPolicy, fn PullErrFnT[T]) PullErrFnT[T] {
+	return func() (v T, ok bool, err error) {
+		attempts := p.maxAttempts()
+		for attempt := 1; ; attempt++ {
+			if err = ctx.Err(); err != nil {
+				return v, false, err
+			}
+			if v, ok, err = fn(); err == nil || !p.retryable(err) {
+				return v, ok, err
+			}
+			if attempt >= attempts {
+				return v, false, fmt.Errorf("%w after %d attempts: %w", ErrRetriesExhausted, attempt, err)
+			}
+			if serr := p.sleep(ctx, p.Delay(attempt)); serr != nil {
+				return v, false, serr
+			}
+		}
+	}
+}
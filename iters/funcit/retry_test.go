@@ -0,0 +1,188 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package funcit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	assertpkg "github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+var (
+	errTemporary = errors.New("temporary")
+	errFatal     = errors.New("fatal")
+)
+
+// flakyPullFn returns a function yielding the given items, failing
+// with the errors given for an item before returning it.
+func flakyPullFn(items []int, errs map[int][]error) (fn PullErrFnT[int], calls *int) {
+	calls = new(int)
+	i := 0
+	return func() (v int, ok bool, err error) {
+		*calls += 1
+		if i >= len(items) {
+			return 0, false, nil
+		}
+		if pending := errs[i]; len(pending) > 0 {
+			errs[i] = pending[1:]
+			return 0, false, pending[0]
+		}
+		v, i = items[i], i+1
+		return v, true, nil
+	}, calls
+}
+
+// recordSleep is a sleeper recording the delays instead of waiting.
+func recordSleep(delays *[]time.Duration) func(context.Context, time.Duration) error {
+	return func(ctx context.Context, d time.Duration) error {
+		*delays = append(*delays, d)
+		return ctx.Err()
+	}
+}
+
+func TestPullErrIterator(t *testing.T) {
+	fn, _ := flakyPullFn([]int{1, 2, 3}, map[int][]error{2: {errFatal}})
+
+	it := PullErrFn(fn)
+	s, err := sliceit.ToErr(it)
+	assertpkg.ErrorIs(t, err, errFatal)
+	assertpkg.Equal(t, []int{1, 2}, s)
+	assertpkg.False(t, it.Next())
+
+	fn, _ = flakyPullFn([]int{1, 2}, nil)
+	s, err = sliceit.ToErr(PullErrFn(fn))
+	assertpkg.NoError(t, err)
+	assertpkg.Equal(t, []int{1, 2}, s)
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	p := RetryPolicy{InitialDelay: time.Second, MaxDelay: 5 * time.Second}
+	assertpkg.Equal(t, time.Second, p.Delay(1))
+	assertpkg.Equal(t, 2*time.Second, p.Delay(2))
+	assertpkg.Equal(t, 4*time.Second, p.Delay(3))
+	assertpkg.Equal(t, 5*time.Second, p.Delay(4))
+	assertpkg.Equal(t, 5*time.Second, p.Delay(1000))
+
+	p = RetryPolicy{}
+	assertpkg.Equal(t, time.Duration(math.MaxInt64), p.Delay(38))
+	assertpkg.Equal(t, time.Duration(math.MaxInt64), p.Delay(100000))
+
+	p = RetryPolicy{Jitter: 0.5, Rand: func() float64 { return 0.5 }}
+	assertpkg.Greater(t, p.Delay(100000), time.Duration(0))
+
+	p = RetryPolicy{Multiplier: 3}
+	assertpkg.Equal(t, DefaultInitialDelay, p.Delay(1))
+	assertpkg.Equal(t, 3*DefaultInitialDelay, p.Delay(2))
+
+	p = RetryPolicy{InitialDelay: time.Second, Jitter: 0.5, Rand: func() float64 { return 0.5 }}
+	assertpkg.Equal(t, 750*time.Millisecond, p.Delay(1))
+
+	p.Rand = nil
+	for i := 0; i < 100; i++ {
+		d := p.Delay(2)
+		assertpkg.LessOrEqual(t, d, 2*time.Second)
+		assertpkg.GreaterOrEqual(t, d, time.Second)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var delays []time.Duration
+	p := RetryPolicy{
+		MaxAttempts:  3,
+		InitialDelay: 10 * time.Millisecond,
+		Sleep:        recordSleep(&delays),
+	}
+
+	fn, calls := flakyPullFn([]int{1, 2, 3}, map[int][]error{
+		0: {errTemporary},
+		2: {errTemporary, errTemporary},
+	})
+
+	s, err := sliceit.ToErr(PullErrFn(Retry(context.Background(), p, fn)))
+	assertpkg.NoError(t, err)
+	assertpkg.Equal(t, []int{1, 2, 3}, s)
+	assertpkg.Equal(t, 7, *calls)
+	assertpkg.Equal(t, []time.Duration{
+		10 * time.Millisecond,
+		10 * time.Millisecond, 20 * time.Millisecond,
+	}, delays)
+}
+
+func TestRetry_Exhausted(t *testing.T) {
+	var delays []time.Duration
+	p := RetryPolicy{MaxAttempts: 2, Sleep: recordSleep(&delays)}
+
+	fn, calls := flakyPullFn([]int{1, 2}, map[int][]error{
+		1: {errTemporary, errTemporary, errTemporary},
+	})
+
+	s, err := sliceit.ToErr(PullErrFn(Retry(context.Background(), p, fn)))
+	assertpkg.ErrorIs(t, err, ErrRetriesExhausted)
+	assertpkg.ErrorIs(t, err, errTemporary)
+	assertpkg.Equal(t, []int{1}, s)
+	assertpkg.Equal(t, 3, *calls)
+	assertpkg.Len(t, delays, 1)
+}
+
+func TestRetry_NotRetryable(t *testing.T) {
+	var delays []time.Duration
+	p := RetryPolicy{
+		MaxAttempts: 5,
+		Retryable:   func(err error) bool { return errors.Is(err, errTemporary) },
+		Sleep:       recordSleep(&delays),
+	}
+
+	fn, calls := flakyPullFn([]int{1, 2}, map[int][]error{
+		0: {errTemporary, errFatal},
+	})
+
+	s, err := sliceit.ToErr(PullErrFn(Retry(context.Background(), p, fn)))
+	assertpkg.ErrorIs(t, err, errFatal)
+	assertpkg.NotErrorIs(t, err, ErrRetriesExhausted)
+	assertpkg.Empty(t, s)
+	assertpkg.Equal(t, 2, *calls)
+	assertpkg.Len(t, delays, 1)
+}
+
+func TestRetry_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fn, calls := flakyPullFn([]int{1}, map[int][]error{0: {errTemporary}})
+
+	// A done context stops before calling fn.
+	s, err := sliceit.ToErr(PullErrFn(Retry(ctx, RetryPolicy{InitialDelay: time.Hour}, fn)))
+	assertpkg.ErrorIs(t, err, context.Canceled)
+	assertpkg.Empty(t, s)
+	assertpkg.Equal(t, 0, *calls)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	// The context is checked again after a sleeper ignoring it.
+	p := RetryPolicy{Sleep: func(context.Context, time.Duration) error { cancel(); return nil }}
+	fn, calls = flakyPullFn([]int{1}, map[int][]error{0: {errTemporary}})
+
+	s, err = sliceit.ToErr(PullErrFn(Retry(ctx, p, fn)))
+	assertpkg.ErrorIs(t, err, context.Canceled)
+	assertpkg.Empty(t, s)
+	assertpkg.Equal(t, 1, *calls)
+}
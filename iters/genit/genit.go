@@ -25,6 +25,9 @@ import (
 	"github.com/0x5a17ed/itkit"
 )
 
+// Ensure Generator conforms to the CloseIterator protocol.
+var _ itkit.CloseIterator[struct{}] = &Generator[struct{}]{}
+
 // YieldFn is a function that is called by a generator to send back
 // values generated by the same generator.
 type YieldFn[O any] func(O)
@@ -53,6 +56,13 @@ func (g *Generator[T]) Value() T {
 	return g.value
 }
 
+// Close stops the wrapped [GeneratorFn], implementing the [io.Closer]
+// interface.  Closing a stopped or finished generator does nothing.
+func (g *Generator[T]) Close() error {
+	g.Stop()
+	return nil
+}
+
 // Iter returns the [Generator] as an [itkit.Iterator] value.
 func (g *Generator[T]) Iter() itkit.Iterator[T] {
 	return g
@@ -61,7 +71,10 @@ func (g *Generator[T]) Iter() itkit.Iterator[T] {
 // Run starts the given [GeneratorFn] function as a new [Generator].
 //
 // The [Generator] will not be stopped automatically and [coro.C.Stop]
-// must be called on the returned generator to stop it manually.
+// or [Generator.Close] must be called on the returned generator to
+// stop it manually unless it is consumed until exhausted.  Helpers
+// like Each from the itlib package close the generator when they
+// stop early.
 func Run[T any](fn GeneratorFn[T]) *Generator[T] {
 	g := &Generator[T]{
 		C: coro.NewSub[any, T](func(_ any, yield func(T) any) {
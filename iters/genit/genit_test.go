@@ -59,3 +59,22 @@ func TestGenerator_IterateHalf(t *testing.T) {
 	// Ensure the generator yielded all the items needed.
 	assert.Equal(t, []int{1, 2, 3, 4, 5}, s)
 }
+
+func TestGenerator_Close(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	asserter := assert.New(t)
+
+	g := genit.Run(func(yield func(int)) {
+		for i := 1; ; i++ {
+			yield(i)
+		}
+	})
+
+	asserter.Equal([]int{1, 2}, sliceit.To(itlib.Limit(2, g.Iter())))
+	asserter.NoError(g.Close())
+	asserter.False(g.Next())
+
+	// Closing twice is fine.
+	asserter.NoError(g.Close())
+}
@@ -44,6 +44,8 @@ type YieldFn[O any] func(O) bool
 // See [YieldFn] for the documentation of the yield argument.
 type GeneratorFn[O any] func(cont bool, yield func(O) bool) error
 
+// Generator wraps a [GeneratorFn] in a [coro.C] coroutine and represents the
+// receiving end.
 type Generator[T any] struct {
 	*coro.C[bool, T]
 
@@ -51,6 +53,9 @@ type Generator[T any] struct {
 	err   atomic.Pointer[error]
 }
 
+// Ensure Generator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &Generator[struct{}]{}
+
 // Next fetches the next value produced by the wrapped [GeneratorFn]
 // and returns true whenever there is a new value available and false
 // otherwise.
@@ -0,0 +1,164 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioit
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// ScanIterator yields the tokens read from an [io.Reader] by a
+// [bufio.Scanner].
+//
+// The configuration fields must not be changed once the first item
+// has been retrieved from the iterator.
+type ScanIterator[T any] struct {
+	// Split is the function splitting the input into tokens,
+	// defaulting to [bufio.ScanLines].
+	Split bufio.SplitFunc
+
+	// MaxTokenSize limits the size of the buffer holding a token
+	// and its delimiter, defaulting to [bufio.MaxScanTokenSize].
+	// Longer tokens stop the iterator with [bufio.ErrTooLong].
+	MaxTokenSize int
+
+	r    io.Reader
+	sc   *bufio.Scanner
+	conv func([]byte) T
+	cur  T
+}
+
+// Ensure ScanIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &ScanIterator[struct{}]{}
+
+func (it *ScanIterator[T]) start() {
+	it.sc = bufio.NewScanner(it.r)
+	if it.Split != nil {
+		it.sc.Split(it.Split)
+	}
+	if it.MaxTokenSize > 0 {
+		it.sc.Buffer(nil, it.MaxTokenSize)
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ScanIterator[T]) Next() bool {
+	if it.sc == nil {
+		it.start()
+	}
+	if !it.sc.Scan() {
+		var zero T
+		it.cur = zero
+		return false
+	}
+	it.cur = it.conv(it.sc.Bytes())
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *ScanIterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns the first error encountered while reading from the
+// [io.Reader] or splitting the input, if any.
+func (it *ScanIterator[T]) Err() error {
+	if it.sc == nil {
+		return nil
+	}
+	return it.sc.Err()
+}
+
+// Iter returns the [ScanIterator] as an [itkit.Iterator] value.
+func (it *ScanIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// ScanDelim returns a [bufio.SplitFunc] splitting the input into
+// records separated by the delimiter delim.  The delimiter is not
+// part of the returned records and the last record does not need to
+// be terminated by the delimiter.
+func ScanDelim(delim byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// ScanRawLines is a [bufio.SplitFunc] like [bufio.ScanLines] keeping
+// the carriage return of lines terminated by "\r\n".
+var ScanRawLines = ScanDelim('\n')
+
+// scanChunks returns a [bufio.SplitFunc] splitting the input into
+// chunks of n bytes, the last chunk holding the remaining bytes.
+func scanChunks(n int) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		switch {
+		case len(data) >= n:
+			return n, data[:n], nil
+		case atEOF && len(data) > 0:
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
+
+// Scan returns a [ScanIterator] yielding the tokens read from r as
+// split by the given [bufio.SplitFunc] split.
+func Scan(r io.Reader, split bufio.SplitFunc) *ScanIterator[[]byte] {
+	return &ScanIterator[[]byte]{Split: split, r: r, conv: bytes.Clone}
+}
+
+// Lines returns a [ScanIterator] yielding the lines read from r
+// without their line ending.
+//
+// Both "\n" and "\r\n" line endings are stripped, set the Split
+// field to [ScanRawLines] to keep the carriage return.
+func Lines(r io.Reader) *ScanIterator[string] {
+	return &ScanIterator[string]{Split: bufio.ScanLines, r: r, conv: toString}
+}
+
+// Records returns a [ScanIterator] yielding the records read from r
+// separated by the delimiter delim.
+func Records(r io.Reader, delim byte) *ScanIterator[string] {
+	return &ScanIterator[string]{Split: ScanDelim(delim), r: r, conv: toString}
+}
+
+// Chunks returns a [ScanIterator] yielding the bytes read from r in
+// chunks of n bytes, the last chunk holding the remaining bytes.
+func Chunks(r io.Reader, n int) *ScanIterator[[]byte] {
+	if n <= 0 {
+		panic("ioit: non-positive chunk size")
+	}
+	return &ScanIterator[[]byte]{
+		Split:        scanChunks(n),
+		MaxTokenSize: max(n, bufio.MaxScanTokenSize),
+		r:            r,
+		conv:         bytes.Clone,
+	}
+}
+
+func toString(b []byte) string { return string(b) }
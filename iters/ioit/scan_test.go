@@ -0,0 +1,128 @@
+// Copyright (c) 2023 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ioit_test
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/ioit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// brokenReader returns a reader yielding s before failing.
+func brokenReader(s string) io.Reader {
+	return io.MultiReader(strings.NewReader(s), iotest.ErrReader(ittest.ErrBroken))
+}
+
+func TestLines(t *testing.T) {
+	tt := []struct {
+		name   string
+		in     string
+		wanted []string
+	}{
+		{"empty", "", nil},
+		{"single", "a", []string{"a"}},
+		{"trailing", "a\nb\n", []string{"a", "b"}},
+		{"crlf", "a\r\nb\r\n\r\nc", []string{"a", "b", "", "c"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			it := ioit.Lines(iotest.OneByteReader(strings.NewReader(tc.in)))
+
+			s, err := sliceit.ToErr(it.Iter())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wanted, s)
+		})
+	}
+}
+
+func TestLines_Raw(t *testing.T) {
+	it := ioit.Lines(strings.NewReader("a\r\nb\nc\r"))
+	it.Split = ioit.ScanRawLines
+
+	assert.Equal(t, []string{"a\r", "b", "c\r"}, sliceit.To(it.Iter()))
+}
+
+func TestLines_MaxTokenSize(t *testing.T) {
+	it := ioit.Lines(strings.NewReader("abc\nabcdefgh\nabc\n"))
+	it.MaxTokenSize = 4
+
+	s, err := sliceit.ToErr(it.Iter())
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+	assert.Equal(t, []string{"abc"}, s)
+}
+
+func TestLines_Err(t *testing.T) {
+	it := ioit.Lines(brokenReader("a\nb\n"))
+
+	s, err := sliceit.ToErr(it.Iter())
+	assert.ErrorIs(t, err, ittest.ErrBroken)
+	assert.Equal(t, []string{"a", "b"}, s)
+}
+
+func TestScan(t *testing.T) {
+	it := ioit.Scan(strings.NewReader("lorem ipsum\n dolor "), bufio.ScanWords)
+
+	assert.Equal(t, [][]byte{
+		[]byte("lorem"), []byte("ipsum"), []byte("dolor"),
+	}, sliceit.To(it.Iter()))
+	assert.NoError(t, it.Err())
+}
+
+func TestRecords(t *testing.T) {
+	it := ioit.Records(strings.NewReader("a\x00b\x00\x00c"), 0)
+
+	assert.Equal(t, []string{"a", "b", "", "c"}, sliceit.To(it.Iter()))
+}
+
+func TestChunks(t *testing.T) {
+	tt := []struct {
+		name   string
+		in     string
+		n      int
+		wanted [][]byte
+	}{
+		{"empty", "", 3, nil},
+		{"even", "abcdef", 3, [][]byte{[]byte("abc"), []byte("def")}},
+		{"uneven", "abcdefg", 3, [][]byte{[]byte("abc"), []byte("def"), []byte("g")}},
+		{"large", strings.Repeat("x", 100_000), 100_000, [][]byte{[]byte(strings.Repeat("x", 100_000))}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			it := ioit.Chunks(iotest.HalfReader(strings.NewReader(tc.in)), tc.n)
+
+			s, err := sliceit.ToErr(it.Iter())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wanted, s)
+		})
+	}
+
+	assert.Panics(t, func() { ioit.Chunks(strings.NewReader(""), 0) })
+}
+
+func TestChunks_Err(t *testing.T) {
+	it := ioit.Chunks(brokenReader("abcde"), 2)
+
+	s, err := sliceit.ToErr(it.Iter())
+	assert.ErrorIs(t, err, ittest.ErrBroken)
+	assert.Equal(t, [][]byte{[]byte("ab"), []byte("cd"), []byte("e")}, s)
+}
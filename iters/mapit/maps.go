@@ -15,6 +15,8 @@
 package mapit
 
 import (
+	"fmt"
+
 	"github.com/0x5a17ed/itkit"
 	"github.com/0x5a17ed/itkit/iters/genit"
 	"github.com/0x5a17ed/itkit/itlib"
@@ -27,9 +29,15 @@ var _ itlib.Pair[struct{}, struct{}] = &ittuple.T2[struct{}, struct{}]{}
 // To builds a Go map from an iterator.
 //
 // To consumes an [itkit.Iterator] that yields [itlib.Pair] values
-// and builds a Go map from all pairs.
+// and builds a Go map from all pairs.  To panics with
+// [itkit.ErrInfinite] if the iterator reports to be infinite.
 func To[K comparable, V any](it itkit.Iterator[itlib.Pair[K, V]]) (out map[K]V) {
-	return itlib.ApplyTo(it, make(map[K]V), func(m map[K]V, p itlib.Pair[K, V]) {
+	h := itkit.SizeHintOf(it)
+	if h.Infinite {
+		panic(fmt.Errorf("mapit: %w", itkit.ErrInfinite))
+	}
+
+	return itlib.ApplyTo(it, make(map[K]V, h.Lower), func(m map[K]V, p itlib.Pair[K, V]) {
 		l, r := p.Values()
 		m[l] = r
 	})
@@ -40,7 +48,8 @@ func To[K comparable, V any](it itkit.Iterator[itlib.Pair[K, V]]) (out map[K]V)
 // In returns a [genit.Generator] yielding [itlib.Pair] values which
 // reflect all values in the given Go map.
 //
-// The returned generator will not be automatically stopped.
+// The returned generator will not be automatically stopped unless it
+// is exhausted, see [genit.Run] for details.
 func In[K comparable, V any](m map[K]V) *genit.Generator[itlib.Pair[K, V]] {
 	return genit.Run(func(yield func(itlib.Pair[K, V])) {
 		for k, v := range m {
@@ -51,7 +60,8 @@ func In[K comparable, V any](m map[K]V) *genit.Generator[itlib.Pair[K, V]] {
 
 // Values returns a [genit.Generator] yielding all values of the given Go map.
 //
-// The returned generator will not be automatically stopped.
+// The returned generator will not be automatically stopped unless it
+// is exhausted, see [genit.Run] for details.
 func Values[K comparable, V any](m map[K]V) *genit.Generator[V] {
 	return genit.Run(func(yield func(V)) {
 		for _, v := range m {
@@ -62,7 +72,8 @@ func Values[K comparable, V any](m map[K]V) *genit.Generator[V] {
 
 // Keys returns a [genit.Generator] yielding all keys of the given Go map.
 //
-// The returned generator will not be automatically stopped.
+// The returned generator will not be automatically stopped unless it
+// is exhausted, see [genit.Run] for details.
 func Keys[K comparable, V any](m map[K]V) *genit.Generator[K] {
 	return genit.Run(func(yield func(K)) {
 		for k := range m {
@@ -32,6 +32,9 @@ func (c *CountIterator[T]) Next() bool {
 	return true
 }
 
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (c *CountIterator[T]) SizeHint() itkit.SizeHint { return itkit.InfiniteSize }
+
 // Count returns an Iterator yielding numbers starting at 0 and
 // increasing by 1.
 func Count[T constraints.Integer]() itkit.Iterator[T] {
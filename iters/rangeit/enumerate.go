@@ -20,25 +20,73 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// indexIterator represents an iterator yielding a finite number of
+// indices, used to enumerate double-ended iterators.
+type indexIterator[I constraints.Integer] struct {
+	start, step   I
+	index, length int
+	current       I
+}
+
+func (it *indexIterator[I]) Value() I { return it.current }
+
+func (it *indexIterator[I]) Next() bool {
+	if it.index >= it.length {
+		return false
+	}
+	it.current = it.start + it.step*I(it.index)
+	it.index += 1
+	return true
+}
+
+func (it *indexIterator[I]) NextBack() bool {
+	if it.index >= it.length {
+		return false
+	}
+	it.length -= 1
+	it.current = it.start + it.step*I(it.length)
+	return true
+}
+
+func (it *indexIterator[I]) SizeHint() itkit.SizeHint {
+	return itkit.ExactSize(it.length - it.index)
+}
+
+func newEnumerate[T any, I constraints.Integer](
+	start, step I,
+	src itkit.Iterator[T],
+) itkit.Iterator[itlib.Pair[I, T]] {
+	if _, ok := src.(itkit.DoubleEndedIterator[T]); ok {
+		if n, ok := itkit.SizeHintOf(src).Exact(); ok {
+			// Keep the enumeration double-ended.
+			return itlib.Zip[I, T](&indexIterator[I]{start: start, step: step, length: n}, src)
+		}
+	}
+	return itlib.Zip(CountStep(start, step), src)
+}
+
 // EnumerateStep returns an iterator that yields pairs containing the
 // index, of the item in the source iterator, starting at a given value
 // which is incremented at a given step value and the item itself.
+//
+// The returned iterator is double-ended if the source iterator is
+// double-ended and reports its exact size.
 func EnumerateStep[T any, I constraints.Integer](
 	start, step I,
 	src itkit.Iterator[T],
 ) itkit.Iterator[itlib.Pair[I, T]] {
-	return itlib.Zip(CountStep(start, step), src)
+	return newEnumerate(start, step, src)
 }
 
 // EnumerateFrom returns an iterator that yields pairs containing the
 // index, of the item in the source iterator, starting at a given value
 // and the item itself.
 func EnumerateFrom[T any, I constraints.Integer](start I, src itkit.Iterator[T]) itkit.Iterator[itlib.Pair[I, T]] {
-	return itlib.Zip(CountFrom(start), src)
+	return newEnumerate(start, 1, src)
 }
 
 // Enumerate returns an iterator that yields pairs containing the index
 // of the item in the source iterator and the item itself.
 func Enumerate[T any](src itkit.Iterator[T]) itkit.Iterator[itlib.Pair[int, T]] {
-	return itlib.Zip(Count[int](), src)
+	return newEnumerate(0, 1, src)
 }
@@ -25,6 +25,9 @@ type RangeIterator[T constraints.Signed] struct {
 	index, start, step, length, current T
 }
 
+// Ensure RangeIterator conforms to the DoubleEndedIterator protocol.
+var _ itkit.DoubleEndedIterator[int] = &RangeIterator[int]{}
+
 func (r *RangeIterator[T]) Value() T { return r.current }
 
 // Next advances the iterator to the first/next item,
@@ -41,6 +44,21 @@ func (r *RangeIterator[T]) Next() bool {
 	return true
 }
 
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (r *RangeIterator[T]) NextBack() bool {
+	if r.index >= r.length {
+		return false
+	}
+	r.length -= 1
+	r.current = r.start + r.step*r.length
+	return true
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (r *RangeIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.ExactSize(int(r.length - r.index))
+}
+
 func newRange[T constraints.Signed](start, stop, step T) itkit.Iterator[T] {
 	stepArg := step
 
@@ -16,14 +16,24 @@ package runeit
 
 import (
 	"bytes"
+	"fmt"
 
 	"github.com/0x5a17ed/itkit"
 )
 
 // ToString consumes the given rune iterator and returns the content as
 // a string.
+//
+// ToString panics with [itkit.ErrInfinite] if the given iterator
+// reports to be infinite.
 func ToString(it itkit.Iterator[rune]) string {
+	h := itkit.SizeHintOf(it)
+	if h.Infinite {
+		panic(fmt.Errorf("runeit: %w", itkit.ErrInfinite))
+	}
+
 	var buf bytes.Buffer
+	buf.Grow(h.Lower)
 	for it.Next() {
 		buf.WriteRune(it.Value())
 	}
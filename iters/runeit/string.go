@@ -28,13 +28,17 @@ type StringIterator struct {
 	nonASCIIStart int
 
 	bytePos int
+	endPos  int
 	current rune
 }
 
+// Ensure StringIterator conforms to the DoubleEndedIterator protocol.
+var _ itkit.DoubleEndedIterator[rune] = &StringIterator{}
+
 func (it *StringIterator) Value() rune { return it.current }
 
 func (it *StringIterator) Next() bool {
-	if it.bytePos >= len(it.value) {
+	if it.bytePos >= it.endPos {
 		return false
 	}
 
@@ -42,16 +46,45 @@ func (it *StringIterator) Next() bool {
 	if it.bytePos < it.nonASCIIStart {
 		r, w = rune(it.value[it.bytePos]), 1
 	} else {
-		r, w = utf8.DecodeRuneInString(it.value[it.bytePos:])
+		r, w = utf8.DecodeRuneInString(it.value[it.bytePos:it.endPos])
 	}
 	it.current = r
 	it.bytePos += w
 	return true
 }
 
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (it *StringIterator) NextBack() bool {
+	if it.bytePos >= it.endPos {
+		return false
+	}
+
+	r, w := rune(0), 0
+	if it.endPos <= it.nonASCIIStart {
+		r, w = rune(it.value[it.endPos-1]), 1
+	} else {
+		r, w = utf8.DecodeLastRuneInString(it.value[it.bytePos:it.endPos])
+	}
+	it.current = r
+	it.endPos -= w
+	return true
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *StringIterator) SizeHint() itkit.SizeHint {
+	ascii := max(min(it.nonASCIIStart, it.endPos)-it.bytePos, 0)
+	rest := it.endPos - it.bytePos - ascii
+
+	// Each remaining non-ASCII rune takes 1 to utf8.UTFMax bytes.
+	return itkit.SizeHint{
+		Lower: ascii + (rest+utf8.UTFMax-1)/utf8.UTFMax,
+		Upper: ascii + rest,
+	}
+}
+
 // InString returns an iterator which yields all runes in the given string.
 func InString(v string) itkit.Iterator[rune] {
-	it := &StringIterator{value: v}
+	it := &StringIterator{value: v, endPos: len(v)}
 	for i := 0; i < len(it.value); i++ {
 		if it.value[i] >= utf8.RuneSelf {
 			it.nonASCIIStart = i
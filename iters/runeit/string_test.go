@@ -19,6 +19,7 @@ import (
 
 	assertpkg "github.com/stretchr/testify/assert"
 
+	"github.com/0x5a17ed/itkit"
 	"github.com/0x5a17ed/itkit/iters/runeit"
 	"github.com/0x5a17ed/itkit/iters/sliceit"
 )
@@ -39,3 +40,52 @@ func TestString(t *testing.T) {
 		assertpkg.Equal(t, []rune{0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x57, 0xf6, 0x72, 0x6c, 0x64}, s)
 	})
 }
+
+func TestString_SizeHint(t *testing.T) {
+	t.Run("ascii", func(t *testing.T) {
+		it := runeit.InString("Hello")
+		assertpkg.Equal(t, itkit.ExactSize(5), itkit.SizeHintOf(it))
+
+		it.Next()
+		assertpkg.Equal(t, itkit.ExactSize(4), itkit.SizeHintOf(it))
+	})
+
+	t.Run("unicode", func(t *testing.T) {
+		// 2 ASCII bytes followed by 6 non-ASCII bytes.
+		it := runeit.InString("Hi日本")
+		assertpkg.Equal(t, itkit.SizeHint{Lower: 4, Upper: 8}, itkit.SizeHintOf(it))
+
+		it.Next()
+		it.Next()
+		it.Next()
+		assertpkg.Equal(t, itkit.SizeHint{Lower: 1, Upper: 3}, itkit.SizeHintOf(it))
+	})
+}
+
+func TestString_NextBack(t *testing.T) {
+	t.Run("unicode", func(t *testing.T) {
+		it := runeit.InString("日本\x80語").(itkit.DoubleEndedIterator[rune])
+
+		var s []rune
+		for it.NextBack() {
+			s = append(s, it.Value())
+		}
+		assertpkg.Equal(t, []rune{0x8A9E, 0xFFFD, 0x672C, 0x65E5}, s)
+	})
+
+	t.Run("meet", func(t *testing.T) {
+		asserter := assertpkg.New(t)
+
+		it := runeit.InString("aö日b").(itkit.DoubleEndedIterator[rune])
+		asserter.True(it.NextBack())
+		asserter.Equal('b', it.Value())
+		asserter.True(it.Next())
+		asserter.Equal('a', it.Value())
+		asserter.True(it.NextBack())
+		asserter.Equal('日', it.Value())
+		asserter.True(it.Next())
+		asserter.Equal('ö', it.Value())
+		asserter.False(it.Next())
+		asserter.False(it.NextBack())
+	})
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package seqit allows for Go range-over-func sequences to be used
+// with iterators and iterators to be used with the range keyword.
+//
+// Iterator functions:
+//   - [In] - yields items of an [iter.Seq] sequence
+//   - [In2] - yields key-value pairs of an [iter.Seq2] sequence
+//   - [To] - converts an iterator to an [iter.Seq] sequence
+//   - [To2] - converts a pair iterator to an [iter.Seq2] sequence
+package seqit
@@ -0,0 +1,114 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seqit
+
+import (
+	"iter"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/itlib"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// PullIterator represents an iterator which pulls items from a Go
+// range-over-func sequence until the sequence is exhausted.
+type PullIterator[T any] struct {
+	next func() (T, bool)
+	stop func()
+	cur  T
+}
+
+// Ensure PullIterator conforms to the CloseIterator protocol.
+var _ itkit.CloseIterator[struct{}] = &PullIterator[struct{}]{}
+
+func (it *PullIterator[T]) Value() T { return it.cur }
+
+func (it *PullIterator[T]) Next() (ok bool) {
+	if it.cur, ok = it.next(); !ok {
+		// The sequence is exhausted, release it right away.
+		it.stop()
+	}
+	return
+}
+
+// Stop stops the underlying sequence.  Calling Next after Stop
+// reports no more items.
+func (it *PullIterator[T]) Stop() { it.stop() }
+
+// Close stops the underlying sequence, implementing the [io.Closer]
+// interface.
+func (it *PullIterator[T]) Close() error { it.stop(); return nil }
+
+// Iter returns the [PullIterator] as an [itkit.Iterator] value.
+func (it *PullIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// In provides an iterator which yields all items of the given
+// [iter.Seq] sequence.
+//
+// The returned iterator is stopped automatically once the sequence
+// is exhausted, [PullIterator.Stop] must be called when the iterator
+// is abandoned before that.
+func In[T any](seq iter.Seq[T]) *PullIterator[T] {
+	next, stop := iter.Pull(seq)
+	return &PullIterator[T]{next: next, stop: stop}
+}
+
+// In2 provides an iterator which yields all key-value pairs of the
+// given [iter.Seq2] sequence as [itlib.Pair] values.
+//
+// See [In] for details on stopping the returned iterator.
+func In2[K, V any](seq iter.Seq2[K, V]) *PullIterator[itlib.Pair[K, V]] {
+	return In(func(yield func(itlib.Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(ittuple.T2[K, V]{Left: k, Right: v}) {
+				return
+			}
+		}
+	})
+}
+
+// To returns an [iter.Seq] sequence yielding the items of the given
+// [itkit.Iterator] for use with the range keyword.
+//
+// The iterator is closed when the loop body stops the iteration
+// early, see [itkit.Close].
+func To[T any](it itkit.Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Value()) {
+				_ = itkit.Close(it)
+				return
+			}
+		}
+	}
+}
+
+// To2 returns an [iter.Seq2] sequence yielding the values of the
+// [itlib.Pair] items of the given [itkit.Iterator] for use with the
+// range keyword.
+//
+// See [To] for details on closing the iterator.
+func To2[K, V any](it itkit.Iterator[itlib.Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for it.Next() {
+			if !yield(it.Value().Values()) {
+				_ = itkit.Close(it)
+				return
+			}
+		}
+	}
+}
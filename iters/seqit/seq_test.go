@@ -0,0 +1,116 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seqit_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit/iters/genit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/seqit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+func TestIn(t *testing.T) {
+	t.Run("exhausted", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		asserter := assert.New(t)
+
+		it := seqit.In(slices.Values([]int{1, 2, 3}))
+		asserter.Equal([]int{1, 2, 3}, sliceit.To(it.Iter()))
+
+		// An exhausted iterator stays exhausted.
+		asserter.False(it.Next())
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		asserter := assert.New(t)
+
+		it := seqit.In(func(yield func(int) bool) {
+			for i := 0; yield(i); i++ {
+			}
+		})
+		asserter.Equal([]int{0, 1, 2}, sliceit.To(itlib.Limit(3, it.Iter())))
+
+		it.Stop()
+		asserter.False(it.Next())
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		it := seqit.In(maps.Keys(map[string]int{"a": 1, "b": 2, "c": 3}))
+
+		got := sliceit.To(it.Iter())
+		slices.Sort(got)
+		assert.Equal(t, []string{"a", "b", "c"}, got)
+	})
+}
+
+func TestIn2(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	it := seqit.In2(slices.All([]string{"a", "b"}))
+
+	assert.Equal(t, []itlib.Pair[int, string]{
+		ittuple.T2[int, string]{Left: 0, Right: "a"},
+		ittuple.T2[int, string]{Left: 1, Right: "b"},
+	}, sliceit.To(it.Iter()))
+}
+
+func TestTo(t *testing.T) {
+	t.Run("collect", func(t *testing.T) {
+		it := itlib.Map(rangeit.Range(5), func(v int) int { return v * v })
+		assert.Equal(t, []int{0, 1, 4, 9, 16}, slices.Collect(seqit.To(it)))
+	})
+
+	t.Run("break", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		g := genit.Run(func(yield func(int)) {
+			for i := 0; ; i++ {
+				yield(i)
+			}
+		})
+
+		var got []int
+		for v := range seqit.To(itlib.Map(g.Iter(), func(v int) int { return v * 2 })) {
+			if got = append(got, v); len(got) == 3 {
+				break
+			}
+		}
+		assert.Equal(t, []int{0, 2, 4}, got)
+
+		// Breaking out of the loop closed the generator.
+		assert.False(t, g.Next())
+	})
+}
+
+func TestTo2(t *testing.T) {
+	l, r := itlib.Tee(rangeit.Range(3))
+	it := itlib.Zip(l.Iter(), itlib.Map(r.Iter(), func(v int) string {
+		return string(rune('a' + v))
+	}))
+
+	assert.Equal(t, map[int]string{0: "a", 1: "b", 2: "c"}, maps.Collect(seqit.To2(it)))
+}
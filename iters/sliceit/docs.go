@@ -17,5 +17,7 @@
 //
 // Iterator functions:
 //   - [To] - convert a slice iterator to a native Go slice
+//   - [ToErr] - like [To], also reporting the error of the iterator
+//   - [ToContext] - like [ToErr], stopping once a context is done
 //   - [In] - provides an iterator from a native Go slice
 package sliceit
@@ -15,6 +15,9 @@
 package sliceit
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/0x5a17ed/itkit"
 )
 
@@ -24,30 +27,96 @@ type SliceIterator[T any] struct {
 	Data []T
 
 	index int
+	back  int
 	cur   T
 }
 
-// Ensure SliceIterator conforms to the Iterator protocol.
-var _ itkit.Iterator[[]struct{}] = &SliceIterator[[]struct{}]{}
+// Ensure SliceIterator conforms to the DoubleEndedIterator protocol.
+var _ itkit.DoubleEndedIterator[[]struct{}] = &SliceIterator[[]struct{}]{}
 
 func (it *SliceIterator[T]) Value() T { return it.cur }
 
 func (it *SliceIterator[T]) Next() (ok bool) {
-	if ok = it.index < len(it.Data); ok {
+	if ok = it.index < len(it.Data)-it.back; ok {
 		it.cur, it.index = it.Data[it.index], it.index+1
 	}
 	return
 }
 
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (it *SliceIterator[T]) NextBack() (ok bool) {
+	if ok = it.index < len(it.Data)-it.back; ok {
+		it.back += 1
+		it.cur = it.Data[len(it.Data)-it.back]
+	}
+	return
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *SliceIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.ExactSize(len(it.Data) - it.index - it.back)
+}
+
 // In returns an [Iterator] yielding items in the given slice.
 func In[T any](s []T) itkit.Iterator[T] {
 	return &SliceIterator[T]{Data: s}
 }
 
 // To consumes the [Iterator] returning its elements as a Go slice.
-func To[T any](it itkit.Iterator[T]) (out []T) {
+//
+// The slice is preallocated from the [itkit.SizeHint] of the
+// [Iterator].  To panics with [itkit.ErrInfinite] if the [Iterator]
+// reports to be infinite.
+func To[T any](it itkit.Iterator[T]) []T {
+	out, err := collect(it)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// ToErr consumes the [Iterator] returning its elements as a Go slice
+// together with the error reported by the [Iterator], if any.
+//
+// ToErr fails with [itkit.ErrInfinite] without consuming any items if
+// the [Iterator] reports to be infinite.
+func ToErr[T any](it itkit.Iterator[T]) ([]T, error) {
+	out, err := collect(it)
+	if err != nil {
+		return nil, err
+	}
+	return out, itkit.Err(it)
+}
+
+func collect[T any](it itkit.Iterator[T]) (out []T, err error) {
+	h := itkit.SizeHintOf(it)
+	if h.Infinite {
+		return nil, fmt.Errorf("sliceit: %w", itkit.ErrInfinite)
+	}
+	if h.Lower > 0 {
+		out = make([]T, 0, h.Lower)
+	}
+
 	for it.Next() {
 		out = append(out, it.Value())
 	}
 	return
 }
+
+// ToContext behaves like [ToErr] and stops once the given context is
+// done, returning the items consumed so far and the error of the
+// context.
+//
+// The [Iterator] is closed when stopped by the context.
+func ToContext[T any](ctx context.Context, it itkit.Iterator[T]) (out []T, err error) {
+	for {
+		if err = ctx.Err(); err != nil {
+			_ = itkit.Close(it)
+			return
+		}
+		if !it.Next() {
+			return out, itkit.Err(it)
+		}
+		out = append(out, it.Value())
+	}
+}
@@ -15,10 +15,14 @@
 package sliceit_test
 
 import (
+	"context"
+	"io/fs"
 	"testing"
 
 	assertpkg "github.com/stretchr/testify/assert"
 
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/ioit"
 	"github.com/0x5a17ed/itkit/iters/rangeit"
 	"github.com/0x5a17ed/itkit/iters/sliceit"
 	"github.com/0x5a17ed/itkit/itlib"
@@ -38,6 +42,14 @@ func TestSlice(t *testing.T) {
 	})
 }
 
+func TestTo_Preallocate(t *testing.T) {
+	s := sliceit.To(itlib.Limit(5, rangeit.Count[int]()))
+	assertpkg.Equal(t, []int{0, 1, 2, 3, 4}, s)
+	assertpkg.Equal(t, 5, cap(s))
+
+	assertpkg.Panics(t, func() { sliceit.To(rangeit.Count[int]()) })
+}
+
 func TestSliceIterator(t *testing.T) {
 	it := sliceit.In([]int{1, 2, 3})
 
@@ -48,3 +60,52 @@ func TestSliceIterator(t *testing.T) {
 
 	assertpkg.Equal(t, []int{1, 2, 3}, values)
 }
+
+func TestToErr(t *testing.T) {
+	g := ioit.Run(func(cont bool, yield func(int) bool) error {
+		for i := 1; cont && i < 3; i++ {
+			cont = yield(i)
+		}
+		return fs.ErrNotExist
+	})
+
+	s, err := sliceit.ToErr(itlib.Map(g.Iter(), func(v int) int { return v * 10 }))
+	assertpkg.Equal(t, []int{10, 20}, s)
+	assertpkg.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestToContext(t *testing.T) {
+	t.Run("done", func(t *testing.T) {
+		s, err := sliceit.ToContext(context.Background(), rangeit.Range(3))
+		assertpkg.Equal(t, []int{0, 1, 2}, s)
+		assertpkg.NoError(t, err)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		it := itlib.Map(rangeit.Count[int](), func(v int) int {
+			if v == 2 {
+				cancel()
+			}
+			return v
+		})
+
+		s, err := sliceit.ToContext(ctx, it)
+		assertpkg.Equal(t, []int{0, 1, 2}, s)
+		assertpkg.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSliceIterator_NextBack(t *testing.T) {
+	asserter := assertpkg.New(t)
+
+	it := sliceit.In([]int{1, 2, 3, 4}).(itkit.DoubleEndedIterator[int])
+	asserter.True(it.NextBack())
+	asserter.Equal(4, it.Value())
+	asserter.True(it.Next())
+	asserter.Equal(1, it.Value())
+	asserter.Equal(itkit.ExactSize(2), itkit.SizeHintOf[int](it))
+	asserter.Equal([]int{2, 3}, sliceit.To[int](it))
+	asserter.False(it.NextBack())
+}
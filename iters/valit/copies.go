@@ -30,6 +30,9 @@ type CopyIterator[T Copier[T]] struct{ src, cur T }
 func (it CopyIterator[T]) Value() T   { return it.cur }
 func (it CopyIterator[T]) Next() bool { it.cur = it.src.Copy(); return true }
 
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it CopyIterator[T]) SizeHint() itkit.SizeHint { return itkit.InfiniteSize }
+
 // Copies provides an iterator which yields copies of a given value on
 // every iteration of the iterator.
 func Copies[T Copier[T]](v T) itkit.Iterator[T] {
@@ -24,6 +24,9 @@ type FillIterator[T any] struct{ v T }
 func (it FillIterator[T]) Value() T   { return it.v }
 func (it FillIterator[T]) Next() bool { return true }
 
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it FillIterator[T]) SizeHint() itkit.SizeHint { return itkit.InfiniteSize }
+
 // Fill provides an iterator which yields the same value over and over again.
 func Fill[T any](v T) itkit.Iterator[T] {
 	return &FillIterator[T]{v: v}
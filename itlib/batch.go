@@ -0,0 +1,193 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"sync"
+	"time"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/itclock"
+)
+
+// BatchIterator yields slices of items retrieved from a source once
+// either the maximum batch size is reached or the maximum wait time
+// passed since the first item of the batch has been retrieved,
+// whichever comes first.
+//
+// Items are received from a channel, allowing a batch to be emitted
+// while the source is waiting for new items.  Pull sources are
+// consumed on a separate goroutine feeding that channel, which must
+// be shut down with [BatchIterator.Close] if the iterator is
+// abandoned before being exhausted.
+type BatchIterator[T any] struct {
+	// Size specifies the maximum number of items in a batch, a
+	// batch is not limited in size if Size is not positive.
+	Size int
+
+	// Wait specifies the maximum time to wait for a batch to
+	// fill up after its first item arrived, a batch is only
+	// emitted once full if Wait is not positive.
+	Wait time.Duration
+
+	// Clock is used to measure Wait, defaulting to [itclock.Real].
+	Clock itclock.Clock
+
+	ch  <-chan T
+	cur []T
+
+	// src is the pull source fed into ch by a goroutine.
+	src     itkit.Iterator[T]
+	started bool
+	stopped bool
+	done    chan struct{}
+	wg      sync.WaitGroup
+	err     error
+}
+
+// Ensure BatchIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[[]struct{}] = &BatchIterator[struct{}]{}
+
+func (it *BatchIterator[T]) start() {
+	it.started = true
+	if it.src == nil {
+		return
+	}
+
+	ch := make(chan T)
+	it.ch, it.done = ch, make(chan struct{})
+
+	it.wg.Add(1)
+	go func() {
+		defer it.wg.Done()
+		defer close(ch)
+
+		for it.src.Next() {
+			select {
+			case ch <- it.src.Value():
+			case <-it.done:
+				return
+			}
+		}
+		// Closing ch publishes err to the consumer.
+		it.err = itkit.Err(it.src)
+	}()
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *BatchIterator[T]) Next() bool {
+	if it.stopped {
+		return false
+	}
+	if !it.started {
+		it.start()
+	}
+
+	var (
+		batch   []T
+		timer   itclock.Timer
+		timeout <-chan time.Time
+	)
+	if it.Size > 0 {
+		batch = make([]T, 0, it.Size)
+	}
+
+loop:
+	for it.Size <= 0 || len(batch) < it.Size {
+		select {
+		case v, ok := <-it.ch:
+			if !ok {
+				it.stopped = true
+				break loop
+			}
+			batch = append(batch, v)
+			if timer == nil && it.Wait > 0 {
+				timer = itclock.OrReal(it.Clock).NewTimer(it.Wait)
+				timeout = timer.C()
+			}
+		case <-timeout:
+			break loop
+		}
+	}
+	if timer != nil {
+		timer.Stop()
+	}
+
+	if len(batch) == 0 {
+		it.cur = nil
+		return false
+	}
+	it.cur = batch
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *BatchIterator[T]) Value() []T {
+	return it.cur
+}
+
+// Err returns the error reported by the pull source once the
+// iterator is exhausted, if any.
+func (it *BatchIterator[T]) Err() error {
+	if !it.stopped {
+		return nil
+	}
+	return it.err
+}
+
+// Close stops the goroutine feeding items from a pull source, if
+// any, and closes the source, implementing the [io.Closer] interface.
+//
+// Close waits for a pending call to the Next method of the source
+// to return.
+func (it *BatchIterator[T]) Close() error {
+	if it.done != nil && !it.stopped {
+		close(it.done)
+	}
+	it.stopped = true
+	it.wg.Wait()
+
+	if it.src == nil {
+		return nil
+	}
+	return itkit.Close(it.src)
+}
+
+// Iter returns the [BatchIterator] as an [itkit.Iterator] value.
+func (it *BatchIterator[T]) Iter() itkit.Iterator[[]T] {
+	return it
+}
+
+// BatchChan returns a new [BatchIterator] yielding batches of up to
+// size items received from the given channel ch, emitting a batch
+// early once wait passed since its first item has been received.
+func BatchChan[T any](size int, wait time.Duration, ch <-chan T) *BatchIterator[T] {
+	return &BatchIterator[T]{Size: size, Wait: wait, ch: ch}
+}
+
+// Batch returns a new [BatchIterator] yielding batches of up to size
+// items retrieved from the given source iterator src, emitting a
+// batch early once wait passed since its first item has been
+// retrieved.
+//
+// Iterators returned by [github.com/0x5a17ed/itkit/iters/chanit.In]
+// are received from directly, other sources are consumed on a
+// separate goroutine.
+func Batch[T any](size int, wait time.Duration, src itkit.Iterator[T]) *BatchIterator[T] {
+	if c, ok := src.(interface{ Chan() <-chan T }); ok {
+		return BatchChan(size, wait, c.Chan())
+	}
+	return &BatchIterator[T]{Size: size, Wait: wait, src: src}
+}
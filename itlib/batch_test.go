@@ -0,0 +1,140 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/itclock"
+	"github.com/0x5a17ed/itkit/iters/chanit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+// nextBatch advances the given iterator on a separate goroutine,
+// delivering the next batch or nil once the iterator is exhausted.
+func nextBatch[T any](it *itlib.BatchIterator[T]) <-chan []T {
+	out := make(chan []T, 1)
+	go func() {
+		if it.Next() {
+			out <- it.Value()
+		} else {
+			out <- nil
+		}
+	}()
+	return out
+}
+
+func TestBatch(t *testing.T) {
+	t.Run("size", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.Batch(2, time.Hour, sliceit.In([]int{1, 2, 3, 4, 5}))
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, sliceit.To(it.Iter()))
+		assert.NoError(t, it.Err())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+
+		it := itlib.Batch(2, time.Hour, chanit.In(ch))
+		assert.False(t, it.Next())
+		assert.NoError(t, it.Err())
+	})
+
+	t.Run("err", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.Batch(2, 0, ittest.Failing(1, 2, 3))
+
+		s, err := sliceit.ToErr(it.Iter())
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+		assert.Equal(t, [][]int{{1, 2}, {3}}, s)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.Batch(3, 0, rangeit.Count[int]())
+		assert.True(t, it.Next())
+		assert.Equal(t, []int{0, 1, 2}, it.Value())
+
+		assert.NoError(t, it.Close())
+		assert.False(t, it.Next())
+	})
+}
+
+func TestBatch_Wait(t *testing.T) {
+	tt := []struct {
+		name string
+		fn   func(ch <-chan int) *itlib.BatchIterator[int]
+	}{
+		{"channel", func(ch <-chan int) *itlib.BatchIterator[int] {
+			return itlib.Batch(3, time.Second, chanit.In(ch))
+		}},
+		{"pull", func(ch <-chan int) *itlib.BatchIterator[int] {
+			// Hide the channel to have the source pulled from.
+			src := itlib.Map(chanit.In(ch), func(v int) int { return v })
+			return itlib.Batch(3, time.Second, src)
+		}},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			defer goleak.VerifyNone(t)
+
+			clk := itclock.NewFake(time.Unix(0, 0))
+			ch := make(chan int)
+
+			it := tc.fn(ch)
+			it.Clock = clk
+
+			// A batch is emitted once full.
+			out := nextBatch(it)
+			ch <- 1
+			ch <- 2
+			ch <- 3
+			assert.Equal(t, []int{1, 2, 3}, <-out)
+			assert.Equal(t, 0, clk.Timers())
+
+			// A batch is emitted once the wait time passed
+			// since its first item.
+			out = nextBatch(it)
+			ch <- 4
+			clk.BlockUntil(1)
+			clk.Advance(500 * time.Millisecond)
+			assert.Equal(t, 1, clk.Timers())
+			clk.Advance(500 * time.Millisecond)
+			assert.Equal(t, []int{4}, <-out)
+
+			// The wait time only starts with the first item.
+			out = nextBatch(it)
+			clk.Advance(time.Hour)
+			ch <- 5
+			close(ch)
+			assert.Equal(t, []int{5}, <-out)
+
+			assert.False(t, it.Next())
+			assert.NoError(t, it.Close())
+		})
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+var (
+	// ErrLagExceeded is reported by a [BoundedTeeIterator] dropped
+	// for lagging too far behind its siblings.
+	ErrLagExceeded = errors.New("itlib: tee lagging too far behind")
+)
+
+// LagPolicy specifies how a [BoundedTeeIterator] deals with siblings
+// lagging too far behind.
+type LagPolicy int
+
+const (
+	// BlockOnLag blocks retrieving new items from the source
+	// until the lagging siblings caught up.
+	BlockOnLag LagPolicy = iota
+
+	// DropOnLag detaches the lagging siblings, having them report
+	// [ErrLagExceeded].
+	DropOnLag
+
+	// SpillOnLag moves the items not consumed by the lagging
+	// siblings to their [SpillStore].
+	SpillOnLag
+)
+
+// SpillStore is a first-in-first-out queue holding the items not
+// consumed by a [BoundedTeeIterator] lagging too far behind.
+//
+// A SpillStore implementing [io.Closer] is closed once the
+// [BoundedTeeIterator] owning it is detached.
+type SpillStore[T any] interface {
+	// Push appends the item v to the end of the queue.
+	Push(v T) error
+
+	// Pop removes the item at the front of the queue and returns
+	// it.  The return value ok is false if the queue is empty.
+	Pop() (v T, ok bool, err error)
+}
+
+// TeeLimit configures the bounds of [BoundedTeeIterator] instances.
+type TeeLimit[T any] struct {
+	// MaxLag is the maximum number of items a sibling is allowed
+	// to lag behind the sibling advanced furthest, not limited
+	// if not positive.
+	MaxLag int
+
+	// Policy specifies how siblings lagging further behind are
+	// dealt with.
+	Policy LagPolicy
+
+	// NewStore creates the [SpillStore] for a sibling once it lags
+	// too far behind the first time, required by [SpillOnLag].
+	NewStore func() SpillStore[T]
+}
+
+type boundedTeeNode[T any] struct {
+	data T
+	seq  uint64
+	next *boundedTeeNode[T]
+}
+
+type boundedTeeState[T any] struct {
+	mx   sync.Mutex
+	cond *sync.Cond
+	src  itkit.Iterator[T]
+	lim  TeeLimit[T]
+
+	// seq is the number of items retrieved from src.
+	seq  uint64
+	done bool
+	err  error
+
+	// attached are the siblings not detached yet.
+	attached map[*BoundedTeeIterator[T]]struct{}
+}
+
+// lagging reports whenever the sibling it lags too far behind to
+// retrieve another item from the source.
+func (st *boundedTeeState[T]) lagging(it *BoundedTeeIterator[T]) bool {
+	return st.lim.MaxLag > 0 && st.seq-it.pos.seq >= uint64(st.lim.MaxLag)
+}
+
+// waitLocked blocks until no sibling other than it is lagging too
+// far behind, reporting whenever it had to wait.
+func (st *boundedTeeState[T]) waitLocked(it *BoundedTeeIterator[T]) bool {
+	for o := range st.attached {
+		if o != it && st.lagging(o) {
+			st.cond.Wait()
+			return true
+		}
+	}
+	return false
+}
+
+// enforceLocked applies the [LagPolicy] to the siblings lagging too
+// far behind after an item has been retrieved from the source.
+func (st *boundedTeeState[T]) enforceLocked() {
+	for o := range st.attached {
+		if st.lim.MaxLag <= 0 || st.seq-o.pos.seq <= uint64(st.lim.MaxLag) {
+			continue
+		}
+
+		if st.lim.Policy == DropOnLag {
+			o.err = ErrLagExceeded
+			_ = st.detachLocked(o)
+			continue
+		}
+
+		if o.store == nil {
+			o.store = st.lim.NewStore()
+		}
+		if err := o.store.Push(o.pos.next.data); err != nil {
+			o.err = err
+			_ = st.detachLocked(o)
+			continue
+		}
+		o.pos = o.pos.next
+		o.spilled += 1
+	}
+}
+
+func (st *boundedTeeState[T]) detachLocked(it *BoundedTeeIterator[T]) (err error) {
+	if it.pos == nil {
+		return nil
+	}
+	it.pos = nil
+
+	if c, ok := it.store.(io.Closer); ok {
+		err = c.Close()
+	}
+	it.store, it.spilled = nil, 0
+
+	delete(st.attached, it)
+	st.cond.Broadcast()
+	if len(st.attached) == 0 {
+		err = errors.Join(err, itkit.Close(st.src))
+	}
+	return err
+}
+
+// BoundedTeeIterator is an iterator yielding the same items as its
+// siblings created from a given source iterator, like a
+// [TeeIterator], while bounding the number of items kept in memory
+// for siblings lagging behind.
+//
+// Siblings lagging further behind than allowed by the [TeeLimit]
+// are dealt with as specified by its [LagPolicy].  With [BlockOnLag]
+// the siblings must be consumed from different goroutines, and
+// siblings no longer consumed must be detached to not block their
+// siblings forever.
+//
+// The source iterator is closed once all siblings sharing it have
+// been detached.
+//
+// All [BoundedTeeIterator] instances are safe to use in goroutines.
+type BoundedTeeIterator[T any] struct {
+	st *boundedTeeState[T]
+
+	// pos is the node consumed last, nil once detached.
+	pos *boundedTeeNode[T]
+
+	store   SpillStore[T]
+	spilled int
+
+	cur T
+	err error
+}
+
+// Ensure BoundedTeeIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[struct{}] = &BoundedTeeIterator[struct{}]{}
+
+func (it *BoundedTeeIterator[T]) nextLocked() bool {
+	st := it.st
+	for {
+		switch {
+		case it.pos == nil:
+			return false
+
+		case it.spilled > 0:
+			v, ok, err := it.store.Pop()
+			if err != nil || !ok {
+				if err == nil {
+					err = errors.New("itlib: spill store lost items")
+				}
+				it.err = err
+				_ = st.detachLocked(it)
+				return false
+			}
+			it.cur, it.spilled = v, it.spilled-1
+			return true
+
+		case it.pos.next != nil:
+			it.pos = it.pos.next
+			it.cur = it.pos.data
+			st.cond.Broadcast()
+			return true
+
+		case st.done:
+			return false
+
+		case st.lim.Policy == BlockOnLag && st.waitLocked(it):
+			// Siblings might have changed the state while waiting.
+			continue
+		}
+
+		if !st.src.Next() {
+			st.done, st.err = true, itkit.Err(st.src)
+			st.cond.Broadcast()
+			return false
+		}
+
+		st.seq += 1
+		it.pos.next = &boundedTeeNode[T]{data: st.src.Value(), seq: st.seq}
+		st.enforceLocked()
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *BoundedTeeIterator[T]) Next() bool {
+	it.st.mx.Lock()
+	defer it.st.mx.Unlock()
+
+	return it.nextLocked()
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *BoundedTeeIterator[T]) Value() T {
+	return it.cur
+}
+
+// Err returns [ErrLagExceeded] if the [BoundedTeeIterator] has been
+// dropped, the error of its [SpillStore] if it failed, or the error
+// reported by the source iterator.
+func (it *BoundedTeeIterator[T]) Err() error {
+	it.st.mx.Lock()
+	defer it.st.mx.Unlock()
+
+	if it.err != nil {
+		return it.err
+	}
+	return it.st.err
+}
+
+// Detach detaches the [BoundedTeeIterator] from its siblings,
+// allowing the items not consumed by it to be freed, and closes the
+// source iterator if it was the last sibling attached.
+func (it *BoundedTeeIterator[T]) Detach() error {
+	it.st.mx.Lock()
+	defer it.st.mx.Unlock()
+
+	return it.st.detachLocked(it)
+}
+
+// Close detaches the [BoundedTeeIterator], implementing the
+// [io.Closer] interface.
+func (it *BoundedTeeIterator[T]) Close() error {
+	return it.Detach()
+}
+
+// Iter returns the [BoundedTeeIterator] as an [itkit.Iterator] value.
+func (it *BoundedTeeIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// BoundedTeeN returns n new [BoundedTeeIterator] values bounded by
+// the given [TeeLimit].
+//
+// BoundedTeeN panics if the [TeeLimit] requires spilling items but
+// lacks a NewStore function.
+func BoundedTeeN[T any](src itkit.Iterator[T], n int, lim TeeLimit[T]) []*BoundedTeeIterator[T] {
+	if lim.MaxLag > 0 && lim.Policy == SpillOnLag && lim.NewStore == nil {
+		panic("itlib: TeeLimit with SpillOnLag requires NewStore")
+	}
+	if n == 0 {
+		return nil
+	}
+
+	st := &boundedTeeState[T]{
+		src:      src,
+		lim:      lim,
+		attached: make(map[*BoundedTeeIterator[T]]struct{}, n),
+	}
+	st.cond = sync.NewCond(&st.mx)
+
+	head := &boundedTeeNode[T]{}
+	its := make([]*BoundedTeeIterator[T], n)
+	for i := range its {
+		its[i] = &BoundedTeeIterator[T]{st: st, pos: head}
+		st.attached[its[i]] = struct{}{}
+	}
+	return its
+}
+
+// BoundedTee returns 2 new [BoundedTeeIterator] values bounded by
+// the given [TeeLimit].
+func BoundedTee[T any](src itkit.Iterator[T], lim TeeLimit[T]) (*BoundedTeeIterator[T], *BoundedTeeIterator[T]) {
+	its := BoundedTeeN(src, 2, lim)
+	return its[0], its[1]
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+// sliceStore is a SpillStore keeping the spilled items in memory.
+type sliceStore struct {
+	items  []int
+	pushed int
+	closed bool
+	err    error
+}
+
+func (s *sliceStore) Push(v int) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.items = append(s.items, v)
+	s.pushed += 1
+	return nil
+}
+
+func (s *sliceStore) Pop() (v int, ok bool, err error) {
+	if len(s.items) == 0 {
+		return 0, false, nil
+	}
+	v, s.items = s.items[0], s.items[1:]
+	return v, true, nil
+}
+
+func (s *sliceStore) Close() error { s.closed = true; return nil }
+
+func TestBoundedTee(t *testing.T) {
+	t.Run("n", func(t *testing.T) {
+		assert.Len(t, itlib.BoundedTeeN(itlib.Empty[int](), 0, itlib.TeeLimit[int]{}), 0)
+		assert.Len(t, itlib.BoundedTeeN(itlib.Empty[int](), 3, itlib.TeeLimit[int]{}), 3)
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		l, r := itlib.BoundedTee(rangeit.Range(5), itlib.TeeLimit[int]{})
+
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, sliceit.To(l.Iter()))
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, sliceit.To(r.Iter()))
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		l, r := itlib.BoundedTee(rangeit.Range(10), itlib.TeeLimit[int]{
+			MaxLag: 2,
+			Policy: itlib.DropOnLag,
+		})
+
+		assert.True(t, r.Next())
+		assert.Equal(t, 0, r.Value())
+
+		s, err := sliceit.ToErr(l.Iter())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, s)
+
+		assert.False(t, r.Next())
+		assert.ErrorIs(t, r.Err(), itlib.ErrLagExceeded)
+	})
+
+	t.Run("spill", func(t *testing.T) {
+		var stores []*sliceStore
+		l, r := itlib.BoundedTee(rangeit.Range(10), itlib.TeeLimit[int]{
+			MaxLag: 3,
+			Policy: itlib.SpillOnLag,
+			NewStore: func() itlib.SpillStore[int] {
+				stores = append(stores, &sliceStore{})
+				return stores[len(stores)-1]
+			},
+		})
+
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, sliceit.To(l.Iter()))
+		if assert.Len(t, stores, 1) {
+			assert.Equal(t, 7, stores[0].pushed)
+		}
+
+		s, err := sliceit.ToErr(r.Iter())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, s)
+
+		assert.NoError(t, r.Detach())
+		assert.True(t, stores[0].closed)
+	})
+
+	t.Run("spill without store", func(t *testing.T) {
+		assert.PanicsWithValue(t, "itlib: TeeLimit with SpillOnLag requires NewStore", func() {
+			itlib.BoundedTee(rangeit.Range(5), itlib.TeeLimit[int]{MaxLag: 1, Policy: itlib.SpillOnLag})
+		})
+	})
+
+	t.Run("spill err", func(t *testing.T) {
+		errFull := errors.New("full")
+		l, r := itlib.BoundedTee(rangeit.Range(5), itlib.TeeLimit[int]{
+			MaxLag: 1,
+			Policy: itlib.SpillOnLag,
+			NewStore: func() itlib.SpillStore[int] {
+				return &sliceStore{err: errFull}
+			},
+		})
+
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, sliceit.To(l.Iter()))
+		assert.False(t, r.Next())
+		assert.ErrorIs(t, r.Err(), errFull)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		l, r := itlib.BoundedTee(rangeit.Range(20), itlib.TeeLimit[int]{MaxLag: 4})
+
+		var (
+			wg       sync.WaitGroup
+			consumed atomic.Int32
+			ls       []int
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for l.Next() {
+				ls = append(ls, l.Value())
+				consumed.Add(1)
+			}
+		}()
+
+		// The fast reader blocks once it is ahead too far.
+		assert.Eventually(t, func() bool { return consumed.Load() == 4 }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		assert.EqualValues(t, 4, consumed.Load())
+
+		assert.Equal(t, sliceit.To(rangeit.Range(20)), sliceit.To(r.Iter()))
+		wg.Wait()
+		assert.Equal(t, sliceit.To(rangeit.Range(20)), ls)
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		src := ittest.Closing(20)
+		l, r := itlib.BoundedTee[int](src, itlib.TeeLimit[int]{MaxLag: 1})
+
+		assert.True(t, r.Next())
+		assert.NoError(t, r.Detach())
+		assert.False(t, r.Next())
+		assert.Equal(t, 0, src.Closed)
+
+		// Detached siblings do not block.
+		assert.Equal(t, sliceit.To(rangeit.Range(20)), sliceit.To(l.Iter()))
+		assert.NoError(t, l.Close())
+		assert.Equal(t, 1, src.Closed)
+		assert.NoError(t, l.Close())
+		assert.Equal(t, 1, src.Closed)
+	})
+
+	t.Run("goroutines", func(t *testing.T) {
+		its := itlib.BoundedTeeN(rangeit.Range(1000), 4, itlib.TeeLimit[int]{MaxLag: 8})
+
+		var wg sync.WaitGroup
+		out := make([][]int, len(its))
+		for i, it := range its {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				out[i] = sliceit.To(it.Iter())
+			}()
+		}
+		wg.Wait()
+
+		for i := range out {
+			assert.Equal(t, sliceit.To(rangeit.Range(1000)), out[i])
+		}
+	})
+
+	t.Run("err", func(t *testing.T) {
+		l, r := itlib.BoundedTee(ittest.Failing(1, 2), itlib.TeeLimit[int]{MaxLag: 1, Policy: itlib.DropOnLag})
+
+		s, err := sliceit.ToErr(l.Iter())
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+		assert.Equal(t, []int{1, 2}, s)
+		assert.ErrorIs(t, r.Err(), itlib.ErrLagExceeded)
+	})
+}
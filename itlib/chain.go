@@ -15,17 +15,22 @@
 package itlib
 
 import (
+	"errors"
+
 	"github.com/0x5a17ed/itkit"
-	"github.com/0x5a17ed/itkit/iters/sliceit"
 )
 
 // ChainIterator chains multiple Iterator iterators together,
 // traversing the given iterators until they are exhausted and
 // proceeding with the next iterator.
+//
+// A ChainIterator stops at the first iterator reporting an error
+// which is then reported by [ChainIterator.Err].
 type ChainIterator[T any] struct {
 	iters itkit.Iterator[itkit.Iterator[T]]
 
 	current itkit.Iterator[T]
+	err     error
 }
 
 // Ensure ChainIterator conforms to the Iterator protocol.
@@ -33,15 +38,29 @@ var _ itkit.Iterator[struct{}] = &ChainIterator[struct{}]{}
 
 // Next implements the [itkit.Iterator.Next] interface.
 func (c *ChainIterator[T]) Next() bool {
-	if c.current != nil && c.current.Next() {
+	if c.err != nil {
+		return false
+	}
+	if c.current != nil && c.advance() {
 		return true
 	}
-	for c.iters.Next() {
+	for c.err == nil && c.iters.Next() {
 		c.current = c.iters.Value()
-		if c.current.Next() {
+		if c.advance() {
 			return true
 		}
 	}
+	if c.err == nil {
+		c.err = itkit.Err(c.iters)
+	}
+	return false
+}
+
+func (c *ChainIterator[T]) advance() bool {
+	if c.current.Next() {
+		return true
+	}
+	c.err = itkit.Err(c.current)
 	return false
 }
 
@@ -50,13 +69,93 @@ func (c *ChainIterator[T]) Value() T {
 	return c.current.Value()
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (c *ChainIterator[T]) Err() error {
+	return c.err
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (c *ChainIterator[T]) SizeHint() itkit.SizeHint {
+	h := itkit.ExactSize(0)
+	if c.current != nil {
+		h = itkit.SizeHintOf(c.current)
+	}
+	if l, ok := c.iters.(*iterList[T]); ok {
+		return h.Add(l.itemsHint())
+	}
+	if n, ok := itkit.SizeHintOf(c.iters).Exact(); !ok || n > 0 {
+		// The remaining iterators may yield any number of items.
+		h = h.Add(itkit.UnknownSize)
+	}
+	return h
+}
+
+// Close closes the current iterator and the iterator yielding the
+// remaining iterators, implementing the [io.Closer] interface.
+//
+// Releasing the remaining iterators is left to the iterator yielding
+// them, as traversing it might never end or create the remaining
+// iterators just for closing them.
+func (c *ChainIterator[T]) Close() error {
+	var errs []error
+	if c.current != nil {
+		errs = append(errs, itkit.Close(c.current))
+		c.current = nil
+	}
+	errs = append(errs, itkit.Close(c.iters))
+	return errors.Join(errs...)
+}
+
+// iterList yields the iterators of a slice, closing the iterators
+// not yielded yet when closed.
+type iterList[T any] struct {
+	iters []itkit.Iterator[T]
+	cur   itkit.Iterator[T]
+}
+
+func (l *iterList[T]) Next() bool {
+	if len(l.iters) == 0 {
+		return false
+	}
+	l.cur, l.iters = l.iters[0], l.iters[1:]
+	return true
+}
+
+func (l *iterList[T]) Value() itkit.Iterator[T] {
+	return l.cur
+}
+
+func (l *iterList[T]) SizeHint() itkit.SizeHint {
+	return itkit.ExactSize(len(l.iters))
+}
+
+// itemsHint returns the [itkit.SizeHint] of the items yielded by the
+// iterators not yielded yet.
+func (l *iterList[T]) itemsHint() itkit.SizeHint {
+	h := itkit.ExactSize(0)
+	for _, it := range l.iters {
+		h = h.Add(itkit.SizeHintOf(it))
+	}
+	return h
+}
+
+func (l *iterList[T]) Close() error {
+	errs := make([]error, len(l.iters))
+	for i, it := range l.iters {
+		errs[i] = itkit.Close(it)
+	}
+	l.iters = nil
+	return errors.Join(errs...)
+}
+
 // ChainI returns a ChainIterator chaining multiple Iterator iterators
 // together.
 func ChainI[T any](iters itkit.Iterator[itkit.Iterator[T]]) itkit.Iterator[T] {
 	return &ChainIterator[T]{iters: iters}
 }
 
-// ChainV is the variadic version of ChainI.
+// ChainV is the variadic version of ChainI, closing the iterators
+// not traversed yet when closed.
 func ChainV[T any](iters ...itkit.Iterator[T]) itkit.Iterator[T] {
-	return ChainI(sliceit.In(iters))
+	return ChainI[T](&iterList[T]{iters: iters})
 }
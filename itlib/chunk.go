@@ -18,6 +18,17 @@ import (
 	"github.com/0x5a17ed/itkit"
 )
 
+// borrowedIterator represents an iterator borrowed from its owner,
+// hiding the Close method of the owner from the borrower.
+type borrowedIterator[T any] struct {
+	itkit.ErrIterator[T]
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it borrowedIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf[T](it.ErrIterator)
+}
+
 // ChunkIterator yields iterators yielding up to n items from a source
 // iterator until the source iterator is exhausted.
 type ChunkIterator[T any] struct {
@@ -42,7 +53,7 @@ func (it *ChunkIterator[T]) Next() bool {
 	if _, ok := it.src.Peek(); !ok {
 		return false
 	}
-	it.cur = newLimitIterator(it.n, it.src.Iter())
+	it.cur = newLimitIterator[T](it.n, borrowedIterator[T]{it.src})
 	return true
 }
 
@@ -51,6 +62,40 @@ func (it *ChunkIterator[T]) Value() itkit.Iterator[T] {
 	return it.cur
 }
 
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *ChunkIterator[T]) SizeHint() itkit.SizeHint {
+	h := itkit.SizeHintOf(it.src.Iter())
+	switch {
+	case h.Infinite:
+		return h
+	case it.n == 0:
+		return itkit.UnknownSize
+	}
+
+	// Items not consumed from the current chunk are dropped.
+	var dropped int
+	if it.cur != nil {
+		dropped = int(it.cur.n)
+	}
+
+	n := int(it.n)
+	out := itkit.SizeHint{Lower: (max(h.Lower-dropped, 0) + n - 1) / n, Upper: -1}
+	if h.Bounded() {
+		out.Upper = (max(h.Upper-dropped, 0) + n - 1) / n
+	}
+	return out
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *ChunkIterator[T]) Err() error {
+	return it.src.Err()
+}
+
+// Close implements the [io.Closer] interface.
+func (it *ChunkIterator[T]) Close() error {
+	return it.src.Close()
+}
+
 // Chunk returns a new [ChunkIterator] value.
 func Chunk[T any](n uint, src itkit.Iterator[T]) itkit.Iterator[itkit.Iterator[T]] {
 	return &ChunkIterator[T]{src: newPeekIterator(src), n: n}
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
 	"github.com/0x5a17ed/itkit/iters/sliceit"
 	"github.com/0x5a17ed/itkit/itlib"
 	"github.com/stretchr/testify/assert"
@@ -96,3 +97,15 @@ func TestChunk(t *testing.T) {
 		})
 	}
 }
+
+func TestChunk_PeekIterator(t *testing.T) {
+	// Chunks keep working on iterators looking ahead.
+	src := itlib.Peek(rangeit.Range(7))
+	assert.Equal(t, []int{0, 1, 2}, src.PeekN(3))
+
+	var s [][]int
+	for it := itlib.Chunk(3, src.Iter()); it.Next(); {
+		s = append(s, sliceit.To(it.Value()))
+	}
+	assert.Equal(t, [][]int{{0, 1, 2}, {3, 4, 5}, {6}}, s)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/mapit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func TestClose(t *testing.T) {
+	tt := []struct {
+		name string
+		fn   func(src itkit.Iterator[int]) itkit.Iterator[int]
+	}{
+		{"map", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.Map(src, func(v int) int { return v })
+		}},
+		{"filter", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.Filter(src, func(v int) bool { return true })
+		}},
+		{"limit", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.Limit(2, src)
+		}},
+		{"takewhile", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.TakeWhile(src, func(v int) bool { return true })
+		}},
+		{"peek", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.Peek(src).Iter()
+		}},
+		{"cycle", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.Cycle(src)
+		}},
+		{"chain", func(src itkit.Iterator[int]) itkit.Iterator[int] {
+			return itlib.ChainV(rangeit.Range(1), src)
+		}},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := ittest.Closing(5)
+			it := tc.fn(src)
+
+			assert.True(t, it.Next())
+			assert.NoError(t, itkit.Close(it))
+			assert.Equal(t, 1, src.Closed)
+		})
+	}
+
+	t.Run("chain-remaining", func(t *testing.T) {
+		a, b, c := ittest.Closing(2), ittest.Closing(2), ittest.Closing(2)
+		it := itlib.ChainV[int](a, b, c)
+
+		assert.Equal(t, []int{0, 1, 0}, sliceit.To(itlib.Limit(3, it)))
+		assert.NoError(t, itkit.Close(it))
+		assert.Equal(t, []int{0, 1, 1}, []int{a.Closed, b.Closed, c.Closed})
+	})
+
+	t.Run("chain-infinite", func(t *testing.T) {
+		var created []*ittest.CloseIterator[int]
+		iters := itlib.Map(rangeit.Count[int](), func(int) itkit.Iterator[int] {
+			src := ittest.Closing(2)
+			created = append(created, src)
+			return src
+		})
+
+		it := itlib.ChainI(iters)
+		assert.True(t, it.Next())
+		assert.NoError(t, itkit.Close(it))
+		assert.Len(t, created, 1)
+		assert.Equal(t, 1, created[0].Closed)
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		l, r := ittest.Closing(2), ittest.Closing(3)
+		assert.NoError(t, itkit.Close(itlib.Zip[int, int](l, r)))
+		assert.Equal(t, []int{1, 1}, []int{l.Closed, r.Closed})
+	})
+
+	t.Run("chunk", func(t *testing.T) {
+		src := ittest.Closing(5)
+		it := itlib.Chunk[int](2, src)
+
+		// Closing a chunk leaves the source alone.
+		assert.True(t, it.Next())
+		assert.NoError(t, itkit.Close(it.Value()))
+		assert.Equal(t, 0, src.Closed)
+
+		assert.NoError(t, itkit.Close(it))
+		assert.Equal(t, 1, src.Closed)
+	})
+
+	t.Run("tee", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		src := ittest.Closing(5)
+		its := itlib.TeeN[int](src, 3)
+
+		asserter.NoError(its[0].Close())
+		asserter.NoError(its[0].Close())
+		asserter.False(its[0].Next())
+		asserter.NoError(its[1].Close())
+		asserter.Equal(0, src.Closed)
+
+		// The last remaining copy is still functional.
+		asserter.Equal([]int{0, 1, 2, 3, 4}, sliceit.To(its[2].Iter()))
+
+		asserter.NoError(its[2].Close())
+		asserter.Equal(1, src.Closed)
+	})
+}
+
+func TestClose_EarlyExit(t *testing.T) {
+	tt := []struct {
+		name   string
+		fn     func(it itkit.Iterator[int])
+		closed int
+	}{
+		{"each", func(it itkit.Iterator[int]) {
+			itlib.Each(it, func(v int) bool { return v == 1 })
+		}, 1},
+		{"each-exhausted", func(it itkit.Iterator[int]) {
+			itlib.Each(it, func(v int) bool { return false })
+		}, 0},
+		{"eachn", func(it itkit.Iterator[int]) {
+			itlib.EachN(it, func(i, v int) bool { return i == 1 })
+		}, 1},
+		{"any", func(it itkit.Iterator[int]) {
+			itlib.Any(it, func(v int) bool { return v == 1 })
+		}, 1},
+		{"all", func(it itkit.Iterator[int]) {
+			itlib.All(it, func(v int) bool { return v < 1 })
+		}, 1},
+		{"find", func(it itkit.Iterator[int]) {
+			itlib.Find(it, func(a, b int) bool { return a == b }, 2)
+		}, 1},
+		{"find-missing", func(it itkit.Iterator[int]) {
+			itlib.Find(it, func(a, b int) bool { return a == b }, 7)
+		}, 0},
+		{"head", func(it itkit.Iterator[int]) {
+			itlib.Head(it)
+		}, 1},
+		{"head-exhausted", func(it itkit.Iterator[int]) {
+			itlib.Drop(5, it)
+			itlib.HeadOrElse(it, -1)
+		}, 1},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := ittest.Closing(5)
+			tc.fn(src)
+			assert.Equal(t, tc.closed, src.Closed)
+		})
+	}
+}
+
+func TestClose_Leak(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c", 4: "d"}
+
+	t.Run("limit", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.Limit(2, itlib.Map(mapit.Keys(m).Iter(), func(k int) int { return k }))
+		assert.Len(t, sliceit.To(it), 2)
+		assert.NoError(t, itkit.Close(it))
+	})
+
+	t.Run("head", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		_, ok := itlib.Head(mapit.Keys(m).Iter())
+		assert.True(t, ok)
+	})
+
+	t.Run("any", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		assert.True(t, itlib.Any(mapit.Values(m).Iter(), func(v string) bool { return v != "" }))
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.Zip(mapit.Keys(m).Iter(), mapit.Values(m).Iter())
+		itlib.Each(it, func(p itlib.Pair[int, string]) bool { return true })
+	})
+
+	t.Run("tee", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		l, r := itlib.Tee(mapit.Keys(m).Iter())
+		_, _ = itlib.Head(l.Iter())
+		_, _ = itlib.Head(r.Iter())
+		assert.NoError(t, l.Close())
+		assert.NoError(t, r.Close())
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"context"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// ContextIterator represents an iterator yielding items from a given
+// source iterator until the source iterator is exhausted or the given
+// context is done.
+//
+// The error of the context is reported by [ContextIterator.Err] once
+// the context stopped the iterator.
+type ContextIterator[T any] struct {
+	ctx context.Context
+	src itkit.Iterator[T]
+	err error
+}
+
+// Ensure ContextIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &ContextIterator[struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ContextIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.err = it.ctx.Err(); it.err != nil {
+		return false
+	}
+	return it.src.Next()
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *ContextIterator[T]) Value() T {
+	return it.src.Value()
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *ContextIterator[T]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return itkit.Err(it.src)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *ContextIterator[T]) Close() error {
+	return itkit.Close(it.src)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *ContextIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.src).AtMost()
+}
+
+// WithContext returns a new [ContextIterator] value.
+//
+// The context is checked before advancing the source iterator, a
+// source iterator blocking in Next is not interrupted.
+func WithContext[T any](ctx context.Context, src itkit.Iterator[T]) itkit.Iterator[T] {
+	return &ContextIterator[T]{ctx: ctx, src: src}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Run("exhausted", func(t *testing.T) {
+		it := itlib.WithContext(context.Background(), rangeit.Range(3))
+
+		s, err := sliceit.ToErr(it)
+		assert.Equal(t, []int{0, 1, 2}, s)
+		assert.NoError(t, err)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		it := itlib.WithContext(ctx, rangeit.Count[int]())
+
+		asserter.Equal([]int{0, 1}, sliceit.To(itlib.Limit(2, it)))
+
+		cancel()
+		asserter.False(it.Next())
+		asserter.ErrorIs(itkit.Err(it), context.Canceled)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		_, err := sliceit.ToErr(itlib.WithContext(context.Background(), ittest.Failing(1)))
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+	})
+}
+
+func TestApplyContext(t *testing.T) {
+	asserter := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	src := ittest.Closing(10)
+
+	var got []int
+	err := itlib.ApplyContext(ctx, src, func(v int) {
+		if got = append(got, v); v == 2 {
+			cancel()
+		}
+	})
+	asserter.ErrorIs(err, context.Canceled)
+	asserter.Equal([]int{0, 1, 2}, got)
+	asserter.Equal(1, src.Closed)
+
+	asserter.NoError(itlib.ApplyContext(context.Background(), rangeit.Range(3), func(int) {}))
+}
+
+// cancelIterator cancels its context once exhausted, reporting the
+// cancellation wrapped.
+type cancelIterator struct {
+	*ittest.CloseIterator[int]
+	ctx    context.Context
+	cancel context.CancelFunc
+	err    error
+}
+
+func (it *cancelIterator) Err() error { return it.err }
+
+func (it *cancelIterator) Next() bool {
+	if it.CloseIterator.Next() {
+		return true
+	}
+	it.cancel()
+	it.err = fmt.Errorf("source: %w", it.ctx.Err())
+	return false
+}
+
+func TestEachContext(t *testing.T) {
+	asserter := assert.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	src := ittest.Closing(10)
+	asserter.ErrorIs(itlib.EachContext(ctx, src, func(v int) bool { return false }), context.Canceled)
+	asserter.Equal(1, src.Closed)
+
+	var got []int
+	asserter.NoError(itlib.EachContext(context.Background(), rangeit.Range(5), func(v int) bool {
+		got = append(got, v)
+		return v == 1
+	}))
+	asserter.Equal([]int{0, 1}, got)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	wrapped := &cancelIterator{CloseIterator: ittest.Closing(2), ctx: ctx, cancel: cancel}
+	asserter.ErrorIs(itlib.EachContext(ctx, wrapped, func(v int) bool { return false }), context.Canceled)
+	asserter.Equal(1, wrapped.Closed)
+}
@@ -58,6 +58,34 @@ func (it *CycleIterator[T]) Next() bool {
 	return true
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *CycleIterator[T]) Err() error {
+	return itkit.Err(it.src)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *CycleIterator[T]) Close() error {
+	return itkit.Close(it.src)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *CycleIterator[T]) SizeHint() itkit.SizeHint {
+	if len(it.copies) > 0 {
+		return itkit.InfiniteSize
+	}
+	if it.repeating {
+		return itkit.ExactSize(0)
+	}
+
+	switch h := itkit.SizeHintOf(it.src); {
+	case h.Lower > 0 || h.Infinite:
+		return itkit.InfiniteSize
+	case h.Upper == 0:
+		return itkit.ExactSize(0)
+	}
+	return itkit.UnknownSize
+}
+
 // Cycle returns a new [CycleIterator] value.
 func Cycle[T any](src itkit.Iterator[T]) itkit.Iterator[T] {
 	return &CycleIterator[T]{src: src}
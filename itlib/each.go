@@ -15,6 +15,9 @@
 package itlib
 
 import (
+	"context"
+	"errors"
+
 	"golang.org/x/exp/constraints"
 
 	"github.com/0x5a17ed/itkit"
@@ -30,6 +33,21 @@ func Apply[T any](it itkit.Iterator[T], fn ApplyFn[T]) {
 	}
 }
 
+// ApplyErr behaves like [Apply] and returns the error reported by
+// the given Iterator it, if any.
+func ApplyErr[T any](it itkit.Iterator[T], fn ApplyFn[T]) error {
+	Apply(it, fn)
+	return itkit.Err(it)
+}
+
+// ApplyContext behaves like [ApplyErr] and stops once the given
+// context is done, returning the error of the context.
+//
+// The Iterator it is closed when stopped by the context.
+func ApplyContext[T any](ctx context.Context, it itkit.Iterator[T], fn ApplyFn[T]) error {
+	return closeOnDone(ctx, it, ApplyErr(WithContext(ctx, it), fn))
+}
+
 type ApplyNFn[T any] func(i int, item T)
 
 // ApplyN walks through the given Iterator it and calls ApplyNFn fn
@@ -60,22 +78,52 @@ type EachFn[T any] func(item T) bool
 
 // Each walks through the given Iterator it and calls EachFn fn for
 // every single entry, aborting if EachFn fn returns true.
+//
+// The Iterator it is closed when aborted, see [itkit.Close].
 func Each[T any](it itkit.Iterator[T], fn EachFn[T]) {
 	for it.Next() {
 		if fn(it.Value()) {
+			_ = itkit.Close(it)
 			break
 		}
 	}
 }
 
+// EachErr behaves like [Each] and returns the error reported by the
+// given Iterator it, if any.
+func EachErr[T any](it itkit.Iterator[T], fn EachFn[T]) error {
+	Each(it, fn)
+	return itkit.Err(it)
+}
+
+// EachContext behaves like [EachErr] and stops once the given
+// context is done, returning the error of the context.
+//
+// The Iterator it is closed when stopped by the context.
+func EachContext[T any](ctx context.Context, it itkit.Iterator[T], fn EachFn[T]) error {
+	return closeOnDone(ctx, it, EachErr(WithContext(ctx, it), fn))
+}
+
+// closeOnDone closes the given Iterator it if the given error
+// originates from the given context.
+func closeOnDone[T any](ctx context.Context, it itkit.Iterator[T], err error) error {
+	if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		_ = itkit.Close(it)
+	}
+	return err
+}
+
 type EachNFn[T any] func(i int, item T) bool
 
 // EachN walks through the given Iterator it and calls EachNFn fn for
 // every single entry together with its index, aborting if the given
 // function returns true.
+//
+// The Iterator it is closed when aborted, see [itkit.Close].
 func EachN[T any](it itkit.Iterator[T], fn EachNFn[T]) {
 	for i := 0; it.Next(); i += 1 {
 		if fn(i, it.Value()) {
+			_ = itkit.Close(it)
 			break
 		}
 	}
@@ -93,6 +141,13 @@ func Fold[T, R any](initial R, it itkit.Iterator[T], fn AccumulatorFn[T, R]) (ou
 	return
 }
 
+// FoldErr behaves like [Fold] and additionally returns the error
+// reported by the given Iterator it, if any.
+func FoldErr[T, R any](initial R, it itkit.Iterator[T], fn AccumulatorFn[T, R]) (R, error) {
+	out := Fold(initial, it, fn)
+	return out, itkit.Err(it)
+}
+
 // Reduce reduces the given Iterator to a value which is the
 // accumulated result of running each value through AccumulatorFn,
 // where each successive invocation of AccumulatorFn is supplied
@@ -104,6 +159,13 @@ func Reduce[T, R any](it itkit.Iterator[T], fn AccumulatorFn[T, R]) (out R) {
 	return Fold(zero, it, fn)
 }
 
+// ReduceErr behaves like [Reduce] and additionally returns the error
+// reported by the given Iterator it, if any.
+func ReduceErr[T, R any](it itkit.Iterator[T], fn AccumulatorFn[T, R]) (R, error) {
+	var zero R
+	return FoldErr(zero, it, fn)
+}
+
 // SumWithInitial accumulates the Iterator values based on the summation of their values.
 func SumWithInitial[T constraints.Ordered](initial T, it itkit.Iterator[T]) T {
 	return Fold[T, T](initial, it, func(a, b T) T { return a + b })
@@ -123,12 +185,16 @@ func Drop[T any](n uint, it itkit.Iterator[T]) itkit.Iterator[T] {
 }
 
 // Any tests if any item in the iterator matches a predicate.
+//
+// The iterator is closed as soon as a matching item is found.
 func Any[T any](it itkit.Iterator[T], fn EachFn[T]) (ok bool) {
 	Each(it, func(x T) bool { ok = fn(x); return ok })
 	return
 }
 
 // All tests if all items in the iterator matches a predicate.
+//
+// The iterator is closed as soon as a mismatching item is found.
 func All[T any](it itkit.Iterator[T], fn EachFn[T]) (ok bool) {
 	Each(it, func(x T) bool { ok = fn(x); return !ok })
 	return
@@ -34,6 +34,11 @@ func (it EmptyIterator[T]) Value() (v T) {
 	return
 }
 
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it EmptyIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.ExactSize(0)
+}
+
 // Empty returns a new [EmptyIterator] value.
 func Empty[T any]() itkit.Iterator[T] {
 	return &EmptyIterator[T]{}
@@ -0,0 +1,116 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func TestErr(t *testing.T) {
+	assert.NoError(t, itkit.Err(rangeit.Range(3)))
+
+	tt := []struct {
+		name   string
+		it     itkit.Iterator[int]
+		wanted []int
+	}{
+		{"map", itlib.Map(ittest.Failing(1, 2), func(v int) int { return v * 2 }), []int{2, 4}},
+		{"filter", itlib.Filter(ittest.Failing(1, 2, 3), func(v int) bool { return v != 2 }), []int{1, 3}},
+		{"limit", itlib.Limit(5, ittest.Failing(1, 2)), []int{1, 2}},
+		{"takewhile", itlib.TakeWhile(ittest.Failing(1, 2), func(v int) bool { return true }), []int{1, 2}},
+		{"peek", itlib.Peek(ittest.Failing(1)).Iter(), []int{1}},
+		{"cycle", itlib.Cycle(ittest.Failing[int]()), []int(nil)},
+		{"tee", itlib.TeeN(ittest.Failing(1), 1)[0].Iter(), []int{1}},
+		{"chain", itlib.ChainV(ittest.Failing(1), rangeit.Range(3)), []int{1}},
+		{"chain-last", itlib.ChainV(rangeit.Range(2), ittest.Failing(7)), []int{0, 1, 7}},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			asserter := assert.New(t)
+
+			got, err := sliceit.ToErr(tc.it)
+			asserter.Equal(tc.wanted, got)
+			asserter.ErrorIs(err, ittest.ErrBroken)
+
+			// The iterator stays exhausted.
+			asserter.False(tc.it.Next())
+			asserter.ErrorIs(itkit.Err(tc.it), ittest.ErrBroken)
+		})
+	}
+
+	t.Run("chunk", func(t *testing.T) {
+		it := itlib.Chunk(2, ittest.Failing(1, 2, 3))
+
+		var got [][]int
+		for it.Next() {
+			got = append(got, sliceit.To(it.Value()))
+		}
+		assert.Equal(t, [][]int{{1, 2}, {3}}, got)
+		assert.ErrorIs(t, itkit.Err(it), ittest.ErrBroken)
+	})
+}
+
+func TestZip_Err(t *testing.T) {
+	t.Run("left", func(t *testing.T) {
+		it := itlib.Zip[int, int](ittest.Failing(1), rangeit.Range(3))
+
+		got, err := sliceit.ToErr(it)
+		assert.Len(t, got, 1)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+	})
+
+	t.Run("right", func(t *testing.T) {
+		it := itlib.Zip[int, int](rangeit.Range(3), ittest.Failing(1))
+
+		got, err := sliceit.ToErr(it)
+		assert.Len(t, got, 1)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+	})
+
+	t.Run("shorter", func(t *testing.T) {
+		it := itlib.Zip[int, int](rangeit.Range(1), ittest.Failing(1, 2))
+
+		got, err := sliceit.ToErr(it)
+		assert.Len(t, got, 1)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTerminals_Err(t *testing.T) {
+	asserter := assert.New(t)
+
+	sum, err := itlib.FoldErr(0, ittest.Failing(1, 2, 3), func(a, b int) int { return a + b })
+	asserter.Equal(6, sum)
+	asserter.ErrorIs(err, ittest.ErrBroken)
+
+	_, err = itlib.ReduceErr(ittest.Failing(1), func(a, b int) int { return a + b })
+	asserter.ErrorIs(err, ittest.ErrBroken)
+
+	var got []int
+	asserter.ErrorIs(itlib.ApplyErr(ittest.Failing(1, 2), func(v int) { got = append(got, v) }), ittest.ErrBroken)
+	asserter.Equal([]int{1, 2}, got)
+
+	asserter.ErrorIs(itlib.EachErr(ittest.Failing(1, 2), func(v int) bool { return false }), ittest.ErrBroken)
+	asserter.NoError(itlib.EachErr(ittest.Failing(1, 2), func(v int) bool { return true }))
+}
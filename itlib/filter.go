@@ -41,8 +41,41 @@ func (f *FilterIter[T]) Next() bool {
 // Value implements the [itkit.Iterator.Value] interface.
 func (f *FilterIter[T]) Value() T { return f.cur }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (f *FilterIter[T]) Err() error { return itkit.Err(f.it) }
+
+// Close implements the [io.Closer] interface.
+func (f *FilterIter[T]) Close() error { return itkit.Close(f.it) }
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (f *FilterIter[T]) SizeHint() itkit.SizeHint { return itkit.SizeHintOf(f.it).AtMost() }
+
+// filterBackIterator is a FilterIter over a double-ended source.
+type filterBackIterator[T any] struct {
+	*FilterIter[T]
+	src itkit.DoubleEndedIterator[T]
+}
+
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (f filterBackIterator[T]) NextBack() bool {
+	var next T
+	for f.src.NextBack() {
+		if next = f.src.Value(); f.fn(next) {
+			f.cur = next
+			return true
+		}
+	}
+	return false
+}
+
 // Filter returns an Iterator yielding items from the given iterator
 // for which the given FilterFn function returns true.
+//
+// The returned iterator is double-ended if the given iterator is.
 func Filter[T any](it itkit.Iterator[T], cb FilterFn[T]) itkit.Iterator[T] {
-	return &FilterIter[T]{it: it, fn: cb}
+	f := &FilterIter[T]{it: it, fn: cb}
+	if de, ok := it.(itkit.DoubleEndedIterator[T]); ok {
+		return filterBackIterator[T]{FilterIter: f, src: de}
+	}
+	return f
 }
@@ -20,10 +20,18 @@ import (
 
 type EqualFn[T any] func(a, b T) bool
 
+// Find returns the first item in the iterator for which EqualFn fn
+// reports equality with the given needle and true, consuming all
+// items up to the found item.  Returns the zero value and false if
+// no such item exists.
+//
+// The iterator is closed once the item has been found.
 func Find[T any](it itkit.Iterator[T], fn EqualFn[T], needle T) (out T, ok bool) {
 	for it.Next() {
 		if fn(it.Value(), needle) {
-			return it.Value(), true
+			out, ok = it.Value(), true
+			_ = itkit.Close(it)
+			return
 		}
 	}
 	return
@@ -32,17 +40,41 @@ func Find[T any](it itkit.Iterator[T], fn EqualFn[T], needle T) (out T, ok bool)
 // Head returns the next value in the iterator and true, if the
 // iterator has a next item, consuming it from the iterator as well.
 // Returns the zero value and false otherwise.
+//
+// The iterator is closed before returning.
 func Head[T any](it itkit.Iterator[T]) (out T, ok bool) {
 	if it.Next() {
 		out, ok = it.Value(), true
 	}
+	_ = itkit.Close(it)
 	return
 }
 
 // HeadOrElse returns the next value in the iterator, if the iterator
 // has a next item, consuming it from the iterator. Returns the
 // provided default value otherwise.
+//
+// The iterator is closed before returning.
 func HeadOrElse[T any](it itkit.Iterator[T], v T) T {
+	if it.Next() {
+		v = it.Value()
+	}
+	_ = itkit.Close(it)
+	return v
+}
+
+// Take behaves like [Head], leaving the iterator open for the
+// remaining items to be consumed.
+func Take[T any](it itkit.Iterator[T]) (out T, ok bool) {
+	if it.Next() {
+		out, ok = it.Value(), true
+	}
+	return
+}
+
+// TakeOrElse behaves like [HeadOrElse], leaving the iterator open
+// for the remaining items to be consumed.
+func TakeOrElse[T any](it itkit.Iterator[T], v T) T {
 	if it.Next() {
 		v = it.Value()
 	}
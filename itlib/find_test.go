@@ -20,6 +20,7 @@ import (
 	assertpkg "github.com/stretchr/testify/assert"
 
 	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
 	"github.com/0x5a17ed/itkit/iters/sliceit"
 	"github.com/0x5a17ed/itkit/itlib"
 )
@@ -89,3 +90,14 @@ func TestHeadOrElse(t *testing.T) {
 		assertpkg.Equal(t, 17, got)
 	})
 }
+
+func TestTake(t *testing.T) {
+	src := ittest.Closing(2)
+
+	v, ok := itlib.Take[int](src)
+	assertpkg.Equal(t, 0, v)
+	assertpkg.True(t, ok)
+	assertpkg.Equal(t, 1, itlib.TakeOrElse[int](src, -1))
+	assertpkg.Equal(t, -1, itlib.TakeOrElse[int](src, -1))
+	assertpkg.Equal(t, 0, src.Closed)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"fmt"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// KeyFn computes the key of an item.
+type KeyFn[T, K any] func(item T) K
+
+type groupSubIterator[T any, K comparable] struct {
+	parent *GroupByIterator[T, K]
+	key    K
+	done   bool
+}
+
+func (it *groupSubIterator[T, K]) Next() bool {
+	if it.done {
+		return false
+	}
+	if k, ok := it.parent.peek(); !ok || k != it.key {
+		it.done = true
+		return false
+	}
+	it.parent.advance()
+	return true
+}
+
+func (it *groupSubIterator[T, K]) Value() T   { return it.parent.src.Value() }
+func (it *groupSubIterator[T, K]) Err() error { return it.parent.src.Err() }
+
+// GroupByIterator yields pairs containing a key and an iterator
+// yielding the consecutive items of a source iterator sharing the
+// same key as computed by a [KeyFn] function.
+//
+// The iterators yielded by GroupByIterator share the source iterator
+// with their parent.  Items not consumed from the current group are
+// dropped once the GroupByIterator advances to the next group, which
+// also exhausts the iterator of the current group.
+type GroupByIterator[T any, K comparable] struct {
+	src *PeekIterator[T]
+	fn  KeyFn[T, K]
+
+	cur   *groupSubIterator[T, K]
+	key   K
+	keyed bool
+}
+
+// Ensure GroupByIterator implements the iterator interface.
+var _ itkit.Iterator[Pair[struct{}, itkit.Iterator[struct{}]]] = &GroupByIterator[struct{}, struct{}]{}
+
+// peek returns the key of the next item without advancing the source
+// iterator, computing the key only once per item.
+func (it *GroupByIterator[T, K]) peek() (k K, ok bool) {
+	if it.keyed {
+		return it.key, true
+	}
+
+	var v T
+	if v, ok = it.src.Peek(); ok {
+		it.key, it.keyed = it.fn(v), true
+	}
+	return it.key, ok
+}
+
+func (it *GroupByIterator[T, K]) advance() {
+	it.src.Next()
+	it.keyed = false
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *GroupByIterator[T, K]) Next() bool {
+	if it.cur != nil {
+		// Drop any items not consumed in the previous group.
+		for it.cur.Next() {
+		}
+	}
+
+	k, ok := it.peek()
+	if !ok {
+		return false
+	}
+	it.cur = &groupSubIterator[T, K]{parent: it, key: k}
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *GroupByIterator[T, K]) Value() Pair[K, itkit.Iterator[T]] {
+	return ittuple.T2[K, itkit.Iterator[T]]{Left: it.cur.key, Right: it.cur}
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *GroupByIterator[T, K]) Err() error {
+	return it.src.Err()
+}
+
+// Close implements the [io.Closer] interface.
+func (it *GroupByIterator[T, K]) Close() error {
+	return it.src.Close()
+}
+
+// GroupBy returns a new [GroupByIterator] value.
+func GroupBy[T any, K comparable](src itkit.Iterator[T], fn KeyFn[T, K]) itkit.Iterator[Pair[K, itkit.Iterator[T]]] {
+	return &GroupByIterator[T, K]{src: newPeekIterator(src), fn: fn}
+}
+
+// GroupByMap consumes the given Iterator it and collects all items
+// into slices grouped by their key as computed by the given [KeyFn]
+// function, preserving the order of the items with the same key.
+//
+// GroupByMap panics with [itkit.ErrInfinite] if the given Iterator
+// reports to be infinite.
+func GroupByMap[T any, K comparable](it itkit.Iterator[T], fn KeyFn[T, K]) map[K][]T {
+	if itkit.SizeHintOf(it).Infinite {
+		panic(fmt.Errorf("itlib: %w", itkit.ErrInfinite))
+	}
+
+	return ApplyTo(it, make(map[K][]T), func(m map[K][]T, item T) {
+		k := fn(item)
+		m[k] = append(m[k], item)
+	})
+}
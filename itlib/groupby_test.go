@@ -0,0 +1,116 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/runeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func identity[T any](v T) T { return v }
+
+func TestGroupBy(t *testing.T) {
+	type group struct {
+		key   rune
+		items string
+	}
+
+	collect := func(it itkit.Iterator[itlib.Pair[rune, itkit.Iterator[rune]]]) (out []group) {
+		for it.Next() {
+			k, items := it.Value().Values()
+			out = append(out, group{k, runeit.ToString(items)})
+		}
+		return
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, collect(itlib.GroupBy(itlib.Empty[rune](), identity[rune])))
+	})
+
+	t.Run("runs", func(t *testing.T) {
+		it := itlib.GroupBy(runeit.InString("AAAABBBCCDAABBB"), identity[rune])
+		assert.Equal(t, []group{
+			{'A', "AAAA"}, {'B', "BBB"}, {'C', "CC"}, {'D', "D"}, {'A', "AA"}, {'B', "BBB"},
+		}, collect(it))
+	})
+
+	t.Run("keyed", func(t *testing.T) {
+		it := itlib.GroupBy(runeit.InString("aAbBBa"), func(r rune) rune {
+			return []rune(strings.ToUpper(string(r)))[0]
+		})
+		assert.Equal(t, []group{{'A', "aA"}, {'B', "bBB"}, {'A', "a"}}, collect(it))
+	})
+
+	t.Run("skipped", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		it := itlib.GroupBy(rangeit.Range(10), func(v int) int { return v / 3 })
+
+		// Consume the first group partially.
+		asserter.True(it.Next())
+		k, first := it.Value().Values()
+		asserter.Equal(0, k)
+		asserter.Equal(0, itlib.HeadOrElse(first, -1))
+
+		// Skip the second group entirely.
+		asserter.True(it.Next())
+		asserter.True(it.Next())
+		k, third := it.Value().Values()
+		asserter.Equal(2, k)
+		asserter.Equal([]int{6, 7, 8}, sliceit.To(third))
+
+		// Previous groups are exhausted once the parent advanced.
+		asserter.False(first.Next())
+
+		asserter.True(it.Next())
+		k, last := it.Value().Values()
+		asserter.Equal(3, k)
+		asserter.Equal([]int{9}, sliceit.To(last))
+		asserter.False(it.Next())
+	})
+
+	t.Run("key once", func(t *testing.T) {
+		calls := 0
+		it := itlib.GroupBy(rangeit.Range(6), func(v int) int { calls++; return v / 2 })
+		for it.Next() {
+			_, items := it.Value().Values()
+			sliceit.To(items)
+		}
+		assert.Equal(t, 6, calls)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		it := itlib.GroupBy(ittest.Failing(1, 1, 2), identity[int])
+		for it.Next() {
+		}
+		assert.ErrorIs(t, itkit.Err(it), ittest.ErrBroken)
+	})
+}
+
+func TestGroupByMap(t *testing.T) {
+	m := itlib.GroupByMap(rangeit.Range(7), func(v int) bool { return v%2 == 0 })
+	assert.Equal(t, map[bool][]int{true: {0, 2, 4, 6}, false: {1, 3, 5}}, m)
+
+	assert.Panics(t, func() { itlib.GroupByMap(rangeit.Count[int](), identity[int]) })
+}
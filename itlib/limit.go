@@ -15,6 +15,8 @@
 package itlib
 
 import (
+	"math"
+
 	"github.com/0x5a17ed/itkit"
 )
 
@@ -42,6 +44,22 @@ func (it *LimitIterator[T]) Value() (v T) {
 	return it.src.Value()
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *LimitIterator[T]) Err() error {
+	return itkit.Err(it.src)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *LimitIterator[T]) Close() error {
+	return itkit.Close(it.src)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *LimitIterator[T]) SizeHint() itkit.SizeHint {
+	n := int(min(it.n, math.MaxInt))
+	return itkit.SizeHintOf(it.src).Min(itkit.ExactSize(n))
+}
+
 func newLimitIterator[T any](n uint, src itkit.Iterator[T]) *LimitIterator[T] {
 	return &LimitIterator[T]{n: n, src: src}
 }
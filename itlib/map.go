@@ -35,8 +35,37 @@ func (m *MapIterator[T, V]) Next() (ok bool) {
 
 func (m *MapIterator[T, V]) Value() V { return m.cur }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (m *MapIterator[T, V]) Err() error { return itkit.Err(m.it) }
+
+// Close implements the [io.Closer] interface.
+func (m *MapIterator[T, V]) Close() error { return itkit.Close(m.it) }
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (m *MapIterator[T, V]) SizeHint() itkit.SizeHint { return itkit.SizeHintOf(m.it) }
+
+// mapBackIterator is a MapIterator over a double-ended source.
+type mapBackIterator[T, V any] struct {
+	*MapIterator[T, V]
+	src itkit.DoubleEndedIterator[T]
+}
+
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (m mapBackIterator[T, V]) NextBack() (ok bool) {
+	if ok = m.src.NextBack(); ok {
+		m.cur = m.fn(m.src.Value())
+	}
+	return
+}
+
 // Map returns an iterator that applies MapFn function to every item
 // of iterkit.Iterator iterable, yielding the results.
+//
+// The returned iterator is double-ended if the given iterator is.
 func Map[T, V any](it itkit.Iterator[T], fn MapFn[T, V]) itkit.Iterator[V] {
-	return &MapIterator[T, V]{it: it, fn: fn}
+	m := &MapIterator[T, V]{it: it, fn: fn}
+	if de, ok := it.(itkit.DoubleEndedIterator[T]); ok {
+		return mapBackIterator[T, V]{MapIterator: m, src: de}
+	}
+	return m
 }
@@ -0,0 +1,203 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"container/heap"
+	"errors"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// LessFn reports whenever a sorts before b.
+type LessFn[T any] func(a, b T) bool
+
+type mergeItem[T any] struct {
+	value T
+	src   int
+}
+
+type mergeHeap[T any] struct {
+	items []mergeItem[T]
+	less  LessFn[T]
+}
+
+func (h *mergeHeap[T]) Len() int      { return len(h.items) }
+func (h *mergeHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *mergeHeap[T]) Push(x any)    { h.items = append(h.items, x.(mergeItem[T])) }
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if h.less(a.value, b.value) {
+		return true
+	}
+	// Keep equal items in the order of their source iterators.
+	return !h.less(b.value, a.value) && a.src < b.src
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	n := len(h.items) - 1
+	x := h.items[n]
+	h.items = h.items[:n]
+	return x
+}
+
+// MergeIterator represents an iterator merging the items of multiple
+// sorted source iterators into a single sorted stream of items.
+//
+// Items comparing equal are yielded in the order of their source
+// iterators.  Each source iterator is advanced only when its
+// previous item has been yielded.
+type MergeIterator[T any] struct {
+	iters itkit.Iterator[itkit.Iterator[T]]
+	less  LessFn[T]
+
+	srcs    []itkit.Iterator[T]
+	h       *mergeHeap[T]
+	cur     mergeItem[T]
+	refill  bool
+	started bool
+	err     error
+}
+
+// Ensure MergeIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[struct{}] = &MergeIterator[struct{}]{}
+
+// pull advances the source iterator at index i and pushes its next
+// item onto the heap.
+func (it *MergeIterator[T]) pull(i int) {
+	src := it.srcs[i]
+	if src.Next() {
+		heap.Push(it.h, mergeItem[T]{value: src.Value(), src: i})
+	} else if it.err == nil {
+		it.err = itkit.Err(src)
+	}
+}
+
+func (it *MergeIterator[T]) start() {
+	it.started = true
+	if it.srcs, it.err = sliceit.ToErr(it.iters); it.err != nil {
+		return
+	}
+
+	it.h = &mergeHeap[T]{items: make([]mergeItem[T], 0, len(it.srcs)), less: it.less}
+	for i := range it.srcs {
+		if it.pull(i); it.err != nil {
+			return
+		}
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *MergeIterator[T]) Next() bool {
+	if !it.started {
+		it.start()
+	} else if it.refill {
+		// Replace the previous item with the next one from the
+		// same source iterator.
+		it.refill = false
+		it.pull(it.cur.src)
+	}
+
+	if it.err != nil || it.h.Len() == 0 {
+		return false
+	}
+	it.cur, it.refill = heap.Pop(it.h).(mergeItem[T]), true
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *MergeIterator[T]) Value() T {
+	return it.cur.value
+}
+
+// Source returns the index of the source iterator the current item
+// has been retrieved from.
+func (it *MergeIterator[T]) Source() int {
+	return it.cur.src
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *MergeIterator[T]) Err() error {
+	return it.err
+}
+
+// Close closes all source iterators retrieved so far and the
+// iterator yielding them, implementing the [io.Closer] interface.
+//
+// Like [ChainIterator.Close], releasing source iterators not
+// retrieved yet is left to the iterator yielding them.
+func (it *MergeIterator[T]) Close() error {
+	var errs []error
+	for _, src := range it.srcs {
+		errs = append(errs, itkit.Close(src))
+	}
+	return errors.Join(append(errs, itkit.Close(it.iters))...)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *MergeIterator[T]) SizeHint() itkit.SizeHint {
+	if !it.started {
+		return itkit.UnknownSize
+	}
+	if it.err != nil {
+		return itkit.ExactSize(0)
+	}
+
+	h := itkit.ExactSize(it.h.Len())
+	for _, src := range it.srcs {
+		h = h.Add(itkit.SizeHintOf(src))
+	}
+	return h
+}
+
+// Iter returns the [MergeIterator] as an [itkit.Iterator] value.
+func (it *MergeIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// MergeSortedI returns a new [MergeIterator] value merging the
+// iterators yielded by the given iterator in the order defined by
+// the given [LessFn].
+//
+// The given iterator is consumed entirely on the first call to Next,
+// stopping with [itkit.ErrInfinite] if it is known to be infinite.
+func MergeSortedI[T any](less LessFn[T], iters itkit.Iterator[itkit.Iterator[T]]) *MergeIterator[T] {
+	return &MergeIterator[T]{iters: iters, less: less}
+}
+
+// MergeSorted is the variadic version of MergeSortedI, closing the
+// given iterators when closed before the first call to Next.
+func MergeSorted[T any](less LessFn[T], iters ...itkit.Iterator[T]) itkit.Iterator[T] {
+	return MergeSortedI[T](less, &iterList[T]{iters: iters})
+}
+
+// MergeSortedIndexedI behaves like MergeSortedI, yielding pairs
+// containing the index of the source iterator of the item and the
+// item itself.
+func MergeSortedIndexedI[T any](less LessFn[T], iters itkit.Iterator[itkit.Iterator[T]]) itkit.Iterator[Pair[int, T]] {
+	m := MergeSortedI(less, iters)
+	return Map[T, Pair[int, T]](m, func(v T) Pair[int, T] {
+		return ittuple.T2[int, T]{Left: m.Source(), Right: v}
+	})
+}
+
+// MergeSortedIndexed is the variadic version of MergeSortedIndexedI,
+// closing the given iterators like [MergeSorted].
+func MergeSortedIndexed[T any](less LessFn[T], iters ...itkit.Iterator[T]) itkit.Iterator[Pair[int, T]] {
+	return MergeSortedIndexedI[T](less, &iterList[T]{iters: iters})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name   string
+		iters  []itkit.Iterator[int]
+		wanted []int
+	}{
+		{"none", nil, []int(nil)},
+		{"empty", []itkit.Iterator[int]{itlib.Empty[int](), itlib.Empty[int]()}, []int(nil)},
+		{"one", []itkit.Iterator[int]{sliceit.In([]int{1, 2, 3})}, []int{1, 2, 3}},
+		{"interleaved", []itkit.Iterator[int]{
+			sliceit.In([]int{1, 4, 7}),
+			sliceit.In([]int{2, 5, 8}),
+			sliceit.In([]int{3, 6, 9}),
+		}, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"uneven", []itkit.Iterator[int]{
+			sliceit.In([]int{5}),
+			itlib.Empty[int](),
+			rangeit.Range(4),
+			sliceit.In([]int{2, 2, 10}),
+		}, []int{0, 1, 2, 2, 2, 3, 5, 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wanted, sliceit.To(itlib.MergeSorted(intLess, tt.iters...)))
+		})
+	}
+}
+
+func TestMergeSorted_Stable(t *testing.T) {
+	type rec struct {
+		key  int
+		name string
+	}
+	less := func(a, b rec) bool { return a.key < b.key }
+
+	it := itlib.MergeSortedI(less, sliceit.In([]itkit.Iterator[rec]{
+		sliceit.In([]rec{{1, "a1"}, {2, "a2"}}),
+		sliceit.In([]rec{{1, "b1"}, {2, "b2"}}),
+		sliceit.In([]rec{{0, "c0"}, {1, "c1"}}),
+	}))
+
+	var names []string
+	var sources []int
+	for it.Next() {
+		names = append(names, it.Value().name)
+		sources = append(sources, it.Source())
+	}
+	assert.Equal(t, []string{"c0", "a1", "b1", "c1", "a2", "b2"}, names)
+	assert.Equal(t, []int{2, 0, 1, 2, 0, 1}, sources)
+}
+
+func TestMergeSortedIndexed(t *testing.T) {
+	it := itlib.MergeSortedIndexed(intLess, sliceit.In([]int{1, 3}), sliceit.In([]int{2}))
+
+	assert.Equal(t, []itlib.Pair[int, int]{
+		ittuple.T2[int, int]{Left: 0, Right: 1},
+		ittuple.T2[int, int]{Left: 1, Right: 2},
+		ittuple.T2[int, int]{Left: 0, Right: 3},
+	}, sliceit.To(it))
+}
+
+func TestMergeSorted_Lazy(t *testing.T) {
+	asserter := assert.New(t)
+
+	var pulled []int
+	tap := func(n int, src itkit.Iterator[int]) itkit.Iterator[int] {
+		return itlib.Map(src, func(v int) int { pulled = append(pulled, n); return v })
+	}
+
+	it := itlib.MergeSorted(intLess, tap(0, rangeit.Range(3)), tap(1, rangeit.Count[int]()))
+	asserter.Equal([]int{0, 0, 1, 1}, sliceit.To(itlib.Limit(4, it)))
+
+	// One item has been read ahead from every source.
+	asserter.Equal([]int{0, 1, 0, 1, 0}, pulled)
+	asserter.Equal(itkit.InfiniteSize, itkit.SizeHintOf(it))
+}
+
+func TestMergeSorted_Err(t *testing.T) {
+	s, err := sliceit.ToErr(itlib.MergeSorted(intLess, rangeit.Range(5), ittest.Failing(1, 2)))
+	assert.Equal(t, []int{0, 1, 1, 2, 2}, s)
+	assert.ErrorIs(t, err, ittest.ErrBroken)
+}
+
+func TestMergeSorted_Infinite(t *testing.T) {
+	iters := itlib.Map(rangeit.Count[int](), func(int) itkit.Iterator[int] {
+		return rangeit.Range(3)
+	})
+
+	it := itlib.MergeSortedI(intLess, iters)
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), itkit.ErrInfinite)
+}
+
+func TestMergeSorted_Close(t *testing.T) {
+	a, b := ittest.Closing(3), ittest.Closing(3)
+
+	it := itlib.MergeSorted[int](intLess, a, b)
+	assert.True(t, it.Next())
+	assert.NoError(t, itkit.Close(it))
+	assert.Equal(t, []int{1, 1}, []int{a.Closed, b.Closed})
+
+	a, b = ittest.Closing(3), ittest.Closing(3)
+	assert.NoError(t, itkit.Close(itlib.MergeSorted[int](intLess, a, b)))
+	assert.Equal(t, []int{1, 1}, []int{a.Closed, b.Closed})
+
+	// Closing before the first call to Next leaves an infinite
+	// iterator of source iterators alone.
+	var created int
+	iters := itlib.Map(rangeit.Count[int](), func(int) itkit.Iterator[int] {
+		created += 1
+		return ittest.Closing(3)
+	})
+	assert.NoError(t, itkit.Close(itlib.MergeSortedI(intLess, iters)))
+	assert.Zero(t, created)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+type ParallelMapFn[T, V any] func(T) (V, error)
+
+type parallelJob[T any] struct {
+	seq   uint64
+	value T
+}
+
+type parallelResult[V any] struct {
+	seq   uint64
+	value V
+	err   error
+
+	panicked bool
+	panicVal any
+}
+
+// ParallelMapIterator represents an iterator applying a
+// [ParallelMapFn] to the items of a source iterator on multiple
+// goroutines, yielding the results.
+//
+// The source iterator is consumed on a separate goroutine.  Once
+// the [ParallelMapFn] returns an error, the iterator stops and the
+// error is reported by [ParallelMapIterator.Err].  A panic in the
+// [ParallelMapFn] is propagated to the goroutine calling Next.
+//
+// The goroutines are shut down once the iterator is exhausted or
+// stopped by an error.  An iterator abandoned before that must be
+// closed with [ParallelMapIterator.Close].
+type ParallelMapIterator[T, V any] struct {
+	// Workers specifies the number of goroutines calling Fn,
+	// defaulting to [runtime.GOMAXPROCS] if not positive.
+	Workers int
+
+	// Window specifies the maximum number of items retrieved from
+	// Source but not yet consumed, defaulting to twice the number
+	// of workers if not positive.
+	Window int
+
+	// Ordered specifies whenever results are yielded in the order
+	// of the items in Source or as soon as they are available.
+	Ordered bool
+
+	// Source is the original source to yield items from.
+	Source itkit.Iterator[T]
+
+	// Fn is the function applied to every item in Source.
+	Fn ParallelMapFn[T, V]
+
+	started bool
+	stopped bool
+	holding bool
+	done    chan struct{}
+	slots   chan struct{}
+	jobs    chan parallelJob[T]
+	results chan parallelResult[V]
+	wg      sync.WaitGroup
+	srcErr  error
+
+	pending map[uint64]parallelResult[V]
+	seq     uint64
+	cur     V
+	err     error
+}
+
+// Ensure ParallelMapIterator conforms to the CloseIterator protocol.
+var _ itkit.CloseIterator[struct{}] = &ParallelMapIterator[struct{}, struct{}]{}
+
+func (it *ParallelMapIterator[T, V]) start() {
+	workers, window := it.Workers, it.Window
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if window <= 0 {
+		window = 2 * workers
+	}
+
+	it.started = true
+	it.done = make(chan struct{})
+	it.slots = make(chan struct{}, window)
+	it.jobs = make(chan parallelJob[T])
+	it.results = make(chan parallelResult[V], window)
+	it.pending = make(map[uint64]parallelResult[V])
+
+	it.wg.Add(1 + workers)
+	go it.feed()
+	for i := 0; i < workers; i++ {
+		go it.work()
+	}
+	go func() {
+		it.wg.Wait()
+		close(it.results)
+	}()
+}
+
+// feed sends the items of the source iterator to the workers,
+// waiting for a free slot in the window before retrieving an item.
+func (it *ParallelMapIterator[T, V]) feed() {
+	defer it.wg.Done()
+	defer close(it.jobs)
+
+	for seq := uint64(0); ; seq++ {
+		select {
+		case it.slots <- struct{}{}:
+		case <-it.done:
+			return
+		}
+
+		if !it.Source.Next() {
+			it.srcErr = itkit.Err(it.Source)
+			return
+		}
+
+		select {
+		case it.jobs <- parallelJob[T]{seq: seq, value: it.Source.Value()}:
+		case <-it.done:
+			return
+		}
+	}
+}
+
+func (it *ParallelMapIterator[T, V]) work() {
+	defer it.wg.Done()
+
+	for job := range it.jobs {
+		select {
+		case it.results <- it.apply(job):
+		case <-it.done:
+			return
+		}
+	}
+}
+
+func (it *ParallelMapIterator[T, V]) apply(job parallelJob[T]) (r parallelResult[V]) {
+	r.seq = job.seq
+	defer func() {
+		if p := recover(); p != nil {
+			r.panicked, r.panicVal = true, p
+		}
+	}()
+
+	r.value, r.err = it.Fn(job.value)
+	return
+}
+
+// shutdown stops all goroutines and waits for them to return.
+func (it *ParallelMapIterator[T, V]) shutdown() {
+	if !it.started || it.stopped {
+		return
+	}
+	it.stopped = true
+
+	close(it.done)
+	for range it.results {
+		// Wait for the results channel to be closed.
+	}
+}
+
+// release frees the slot of the item yielded last for the next item
+// in the source.
+func (it *ParallelMapIterator[T, V]) release() {
+	if it.holding {
+		<-it.slots
+		it.holding = false
+	}
+}
+
+func (it *ParallelMapIterator[T, V]) yield(r parallelResult[V]) bool {
+	// The slot of the item is held until the item is consumed,
+	// which is once Next is called again.
+	it.holding = true
+
+	if r.panicked {
+		it.shutdown()
+		panic(r.panicVal)
+	}
+	if r.err != nil {
+		it.err = r.err
+		it.shutdown()
+		return false
+	}
+
+	it.cur = r.value
+	return true
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ParallelMapIterator[T, V]) Next() bool {
+	if it.stopped {
+		return false
+	}
+	if !it.started {
+		it.start()
+	}
+	it.release()
+
+	for {
+		if r, ok := it.pending[it.seq]; ok {
+			delete(it.pending, it.seq)
+			it.seq += 1
+			return it.yield(r)
+		}
+
+		r, ok := <-it.results
+		if !ok {
+			// All goroutines returned, Source is exhausted.
+			it.stopped, it.err = true, it.srcErr
+			return false
+		}
+
+		if !it.Ordered {
+			return it.yield(r)
+		}
+		it.pending[r.seq] = r
+	}
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *ParallelMapIterator[T, V]) Value() V {
+	return it.cur
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *ParallelMapIterator[T, V]) Err() error {
+	return it.err
+}
+
+// Close stops all goroutines and closes the source iterator,
+// implementing the [io.Closer] interface.
+//
+// Close waits for running [ParallelMapFn] calls and a pending call
+// to Next of the source iterator to return.
+func (it *ParallelMapIterator[T, V]) Close() error {
+	it.shutdown()
+	it.stopped = true
+	return itkit.Close(it.Source)
+}
+
+// ParallelMap returns an iterator that applies ParallelMapFn fn to
+// every item of the given iterator on n goroutines, yielding the
+// results in the order of the items in the given iterator.
+//
+// See [ParallelMapIterator] for details.
+func ParallelMap[T, V any](n int, it itkit.Iterator[T], fn ParallelMapFn[T, V]) itkit.Iterator[V] {
+	return &ParallelMapIterator[T, V]{Workers: n, Ordered: true, Source: it, Fn: fn}
+}
+
+// ParallelMapUnordered returns an iterator that applies ParallelMapFn
+// fn to every item of the given iterator on n goroutines, yielding
+// the results as soon as they are available.
+//
+// See [ParallelMapIterator] for details.
+func ParallelMapUnordered[T, V any](n int, it itkit.Iterator[T], fn ParallelMapFn[T, V]) itkit.Iterator[V] {
+	return &ParallelMapIterator[T, V]{Workers: n, Source: it, Fn: fn}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"errors"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func slowSquare(v int) (int, error) {
+	// Make later items complete earlier.
+	time.Sleep(time.Duration(10-v%10) * time.Millisecond)
+	return v * v, nil
+}
+
+func TestParallelMap(t *testing.T) {
+	t.Run("ordered", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		s, err := sliceit.ToErr(itlib.ParallelMap(4, rangeit.Range(20), slowSquare))
+		assert.NoError(t, err)
+
+		expected := sliceit.To(itlib.Map(rangeit.Range(20), func(v int) int { return v * v }))
+		assert.Equal(t, expected, s)
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		s, err := sliceit.ToErr(itlib.ParallelMapUnordered(4, rangeit.Range(20), slowSquare))
+		assert.NoError(t, err)
+
+		sort.Ints(s)
+		expected := sliceit.To(itlib.Map(rangeit.Range(20), func(v int) int { return v * v }))
+		assert.Equal(t, expected, s)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		assert.Empty(t, sliceit.To(itlib.ParallelMap(4, itlib.Empty[int](), slowSquare)))
+	})
+
+	t.Run("window", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		var retrieved, consumed, maxInFlight int32
+		src := itlib.Map(rangeit.Range(50), func(v int) int {
+			n := atomic.AddInt32(&retrieved, 1) - atomic.LoadInt32(&consumed)
+			for m := atomic.LoadInt32(&maxInFlight); n > m; m = atomic.LoadInt32(&maxInFlight) {
+				atomic.CompareAndSwapInt32(&maxInFlight, m, n)
+			}
+			return v
+		})
+
+		it := &itlib.ParallelMapIterator[int, int]{
+			Workers: 2,
+			Window:  3,
+			Ordered: true,
+			Source:  src,
+			Fn:      slowSquare,
+		}
+		for it.Next() {
+			atomic.AddInt32(&consumed, 1)
+		}
+		assert.NoError(t, it.Err())
+		assert.LessOrEqual(t, maxInFlight, int32(3))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.ParallelMap(4, rangeit.Range(20), func(v int) (int, error) {
+			if v == 5 {
+				return 0, ittest.ErrBroken
+			}
+			return slowSquare(v)
+		})
+
+		s, err := sliceit.ToErr(it)
+		assert.Equal(t, []int{0, 1, 4, 9, 16}, s)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+		assert.False(t, it.Next())
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		s, err := sliceit.ToErr(itlib.ParallelMap(2, ittest.Failing(1, 2, 3), slowSquare))
+		assert.Equal(t, []int{1, 4, 9}, s)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		it := itlib.ParallelMapUnordered(4, rangeit.Range(20), func(v int) (int, error) {
+			if v == 3 {
+				panic(ittest.ErrBroken)
+			}
+			return v, nil
+		})
+
+		assert.PanicsWithError(t, ittest.ErrBroken.Error(), func() {
+			for it.Next() {
+			}
+		})
+		assert.False(t, it.Next())
+	})
+
+	t.Run("early exit", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		src := ittest.Closing(1000)
+		it := itlib.ParallelMap[int, int](4, src, slowSquare)
+
+		assert.True(t, itlib.Any(it, func(v int) bool { return v == 4 }))
+		assert.Equal(t, 1, src.Closed)
+		assert.False(t, it.Next())
+	})
+
+	t.Run("close unstarted", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		src := ittest.Closing(10)
+		it := itlib.ParallelMap[int, int](4, src, slowSquare)
+		assert.NoError(t, itkit.Close(it))
+		assert.Equal(t, 1, src.Closed)
+		assert.False(t, it.Next())
+	})
+}
+
+func TestParallelMap_Errors(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	// In ordered mode the error is reported in order as well.
+	it := itlib.ParallelMap(4, rangeit.Range(10), func(v int) (int, error) {
+		if v == 2 {
+			return 0, errors.Join(ittest.ErrBroken)
+		}
+		time.Sleep(time.Duration(v) * time.Millisecond)
+		return v, nil
+	})
+
+	s, err := sliceit.ToErr(it)
+	assert.Equal(t, []int{0, 1}, s)
+	assert.ErrorIs(t, err, ittest.ErrBroken)
+}
@@ -18,28 +18,44 @@ import (
 	"github.com/0x5a17ed/itkit"
 )
 
-// PeekIterator represents an iterator allowing to peek the next item
-// before advancing to it.
+// PeekIterator represents an iterator allowing to look ahead at
+// upcoming items before advancing to them, and to push items back
+// into the stream.
+//
+// Items retrieved from the source iterator ahead of time and items
+// pushed back are kept in a ring buffer until they are yielded.
 type PeekIterator[T any] struct {
 	src itkit.Iterator[T]
 
-	cur    T
-	cached T
-	has    bool
+	cur T
+	buf ring[T]
+
+	// unread reports whenever cur can be unread.
+	unread bool
 }
 
 // Ensure PeekIterator implements the iterator interface.
 var _ itkit.Iterator[struct{}] = &PeekIterator[struct{}]{}
 
+// fill retrieves items from the source iterator until n items are
+// buffered or the source iterator is exhausted.
+func (it *PeekIterator[T]) fill(n int) bool {
+	for it.buf.len() < n {
+		if !it.src.Next() {
+			return false
+		}
+		it.buf.pushBack(it.src.Value())
+	}
+	return true
+}
+
 // Next implements the [itkit.Iterator.Next] interface.
 func (it *PeekIterator[T]) Next() (ok bool) {
-	if ok = it.has; ok {
-		// Try to consume the cached item first.
-		it.cur, it.has = it.cached, false
-	} else if ok = it.src.Next(); ok {
-		// Try to get an item from the source iterator.
-		it.cur = it.src.Value()
+	if ok = it.fill(1); ok {
+		// Items are always consumed from the buffer.
+		it.cur = it.buf.popFront()
 	}
+	it.unread = ok
 	return
 }
 
@@ -48,18 +64,79 @@ func (it *PeekIterator[T]) Value() T {
 	return it.cur
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *PeekIterator[T]) Err() error {
+	return itkit.Err(it.src)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *PeekIterator[T]) Close() error {
+	return itkit.Close(it.src)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *PeekIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.src).Add(itkit.ExactSize(it.buf.len()))
+}
+
 // Peek returns the next item without advancing the iterator.
 //
 // Advances the source iterator to the next item only if necessary.
 func (it *PeekIterator[T]) Peek() (v T, ok bool) {
-	if it.has {
-		return it.cached, true
+	return it.PeekAt(0)
+}
+
+// PeekAt returns the i-th upcoming item without advancing the
+// iterator, PeekAt(0) being the same as [PeekIterator.Peek].  The
+// return value ok is false if the iterator has no more than i items
+// left.
+//
+// Advances the source iterator up to the requested item only if
+// necessary.
+func (it *PeekIterator[T]) PeekAt(i int) (v T, ok bool) {
+	if i < 0 || !it.fill(i+1) {
+		return v, false
 	}
+	return it.buf.at(i), true
+}
+
+// PeekN returns up to k upcoming items without advancing the
+// iterator.  Fewer than k items are returned only if the iterator
+// has fewer items left.
+//
+// Advances the source iterator up to the requested items only if
+// necessary.
+func (it *PeekIterator[T]) PeekN(k int) []T {
+	it.fill(k)
 
-	if it.has = it.src.Next(); it.has {
-		it.cached = it.src.Value()
+	out := make([]T, min(max(k, 0), it.buf.len()))
+	for i := range out {
+		out[i] = it.buf.at(i)
 	}
-	return it.cached, it.has
+	return out
+}
+
+// Unread returns the item yielded last back into the stream, having
+// it yielded again by the next call to Next.  Unread returns false
+// if there is no item to unread, either because Next has not yielded
+// any item since the last call to Unread or because of a call to
+// [PeekIterator.PushBack] in between.
+func (it *PeekIterator[T]) Unread() bool {
+	if !it.unread {
+		return false
+	}
+	it.unread = false
+	it.buf.pushFront(it.cur)
+	return true
+}
+
+// PushBack pushes the given item v into the stream, having it
+// yielded by the next call to Next before any other item.  Items
+// pushed back are yielded in the reverse order of the calls to
+// PushBack.
+func (it *PeekIterator[T]) PushBack(v T) {
+	it.unread = false
+	it.buf.pushFront(v)
 }
 
 // Iter returns the [PeekIterator] as an [itkit.Iterator] value.
@@ -67,3 +67,78 @@ func TestPeek(t *testing.T) {
 		asserter.Equal([]int{1, 2, 3, 4}, sliceit.To(it.Iter()))
 	})
 }
+
+func TestPeek_Lookahead(t *testing.T) {
+	t.Run("peek n", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		it := itlib.Peek(rangeit.RangeFrom(1, 6))
+		asserter.Equal([]int{}, it.PeekN(0))
+		asserter.Equal([]int{1, 2, 3}, it.PeekN(3))
+
+		asserter.True(it.Next())
+		asserter.Equal(1, it.Value())
+
+		asserter.Equal([]int{2, 3, 4, 5}, it.PeekN(10))
+		asserter.Equal([]int{2, 3, 4, 5}, sliceit.To(it.Iter()))
+		asserter.Equal([]int{}, it.PeekN(2))
+	})
+
+	t.Run("peek at", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		it := itlib.Peek(rangeit.RangeFrom(1, 4))
+
+		v, ok := it.PeekAt(2)
+		asserter.True(ok)
+		asserter.Equal(3, v)
+
+		v, ok = it.PeekAt(0)
+		asserter.True(ok)
+		asserter.Equal(1, v)
+
+		_, ok = it.PeekAt(3)
+		asserter.False(ok)
+		_, ok = it.PeekAt(-1)
+		asserter.False(ok)
+
+		asserter.Equal([]int{1, 2, 3}, sliceit.To(it.Iter()))
+	})
+
+	t.Run("unread", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		it := itlib.Peek(rangeit.RangeFrom(1, 4))
+		asserter.False(it.Unread())
+
+		asserter.True(it.Next())
+		asserter.True(it.Next())
+		asserter.Equal(2, it.Value())
+
+		asserter.True(it.Unread())
+		asserter.False(it.Unread())
+
+		v, ok := it.Peek()
+		asserter.True(ok)
+		asserter.Equal(2, v)
+
+		asserter.Equal([]int{2, 3}, sliceit.To(it.Iter()))
+		asserter.False(it.Unread())
+	})
+
+	t.Run("push back", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		it := itlib.Peek(rangeit.RangeFrom(1, 4))
+		asserter.True(it.Next())
+
+		asserter.Equal([]int{2, 3}, it.PeekN(2))
+		for i := 10; i < 20; i++ {
+			it.PushBack(i)
+		}
+		asserter.False(it.Unread())
+
+		asserter.Equal([]int{19, 18, 17}, it.PeekN(3))
+		asserter.Equal([]int{19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 2, 3}, sliceit.To(it.Iter()))
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+)
+
+// ReverseIterator represents an iterator yielding the items of a
+// source iterator in reverse order.
+//
+// Items are retrieved from the back of a source iterator conforming
+// to the [itkit.DoubleEndedIterator] protocol.  Any other source
+// iterator is consumed into a slice on the first call to Next.
+type ReverseIterator[T any] struct {
+	src     itkit.DoubleEndedIterator[T]
+	pending itkit.Iterator[T]
+	err     error
+}
+
+// Ensure ReverseIterator conforms to the DoubleEndedIterator protocol.
+var _ itkit.DoubleEndedIterator[struct{}] = &ReverseIterator[struct{}]{}
+
+func (it *ReverseIterator[T]) materialize() {
+	if it.pending == nil {
+		return
+	}
+
+	var data []T
+	data, it.err = sliceit.ToErr(it.pending)
+	it.src, it.pending = &sliceit.SliceIterator[T]{Data: data}, nil
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ReverseIterator[T]) Next() bool {
+	it.materialize()
+	return it.src.NextBack()
+}
+
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (it *ReverseIterator[T]) NextBack() bool {
+	it.materialize()
+	return it.src.Next()
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *ReverseIterator[T]) Value() T {
+	return it.src.Value()
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *ReverseIterator[T]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	if it.src != nil {
+		return itkit.Err[T](it.src)
+	}
+	return nil
+}
+
+// Close implements the [io.Closer] interface.
+func (it *ReverseIterator[T]) Close() error {
+	if it.pending != nil {
+		return itkit.Close(it.pending)
+	}
+	return itkit.Close[T](it.src)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *ReverseIterator[T]) SizeHint() itkit.SizeHint {
+	if it.pending != nil {
+		return itkit.SizeHintOf(it.pending)
+	}
+	return itkit.SizeHintOf[T](it.src)
+}
+
+// Reverse returns a new [ReverseIterator] value.
+func Reverse[T any](src itkit.Iterator[T]) itkit.Iterator[T] {
+	if de, ok := src.(itkit.DoubleEndedIterator[T]); ok {
+		return &ReverseIterator[T]{src: de}
+	}
+	return &ReverseIterator[T]{pending: src}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/funcit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/runeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+func TestReverse(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		s := sliceit.To(itlib.Reverse(sliceit.In([]int{1, 2, 3})))
+		assert.Equal(t, []int{3, 2, 1}, s)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		s := sliceit.To(itlib.Reverse(rangeit.RangeStep(0, 10, 3)))
+		assert.Equal(t, []int{9, 6, 3, 0}, s)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		s := runeit.ToString(itlib.Reverse(runeit.InString("Hallo Wörld 日本")))
+		assert.Equal(t, "本日 dlröW ollaH", s)
+	})
+
+	t.Run("twice", func(t *testing.T) {
+		s := sliceit.To(itlib.Reverse(itlib.Reverse(rangeit.Range(3))))
+		assert.Equal(t, []int{0, 1, 2}, s)
+	})
+
+	t.Run("both ends", func(t *testing.T) {
+		asserter := assert.New(t)
+
+		it := itlib.Reverse(rangeit.Range(5)).(itkit.DoubleEndedIterator[int])
+		asserter.True(it.Next())
+		asserter.Equal(4, it.Value())
+		asserter.True(it.NextBack())
+		asserter.Equal(0, it.Value())
+		asserter.Equal([]int{3, 2, 1}, sliceit.To[int](it))
+		asserter.False(it.NextBack())
+	})
+
+	t.Run("materialized", func(t *testing.T) {
+		n := 0
+		src := funcit.PullFn(func() (int, bool) { n++; return n, n <= 3 })
+
+		it := itlib.Reverse(src)
+		assert.Equal(t, []int{3, 2, 1}, sliceit.To(it))
+	})
+
+	t.Run("materialized error", func(t *testing.T) {
+		s, err := sliceit.ToErr(itlib.Reverse(ittest.Failing(1, 2)))
+		assert.Equal(t, []int{2, 1}, s)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+	})
+}
+
+func TestReverse_Keep(t *testing.T) {
+	t.Run("map", func(t *testing.T) {
+		it := itlib.Map(rangeit.Range(4), func(v int) int { return v * v })
+		assert.Equal(t, []int{9, 4, 1, 0}, sliceit.To(itlib.Reverse(it)))
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		it := itlib.Filter(rangeit.Range(10), func(v int) bool { return v%3 == 0 })
+		assert.Equal(t, []int{9, 6, 3, 0}, sliceit.To(itlib.Reverse(it)))
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		it := itlib.Zip(rangeit.Range(5), sliceit.In([]string{"a", "b", "c"}))
+
+		_, ok := it.(itkit.DoubleEndedIterator[itlib.Pair[int, string]])
+		assert.True(t, ok)
+
+		assert.Equal(t, []itlib.Pair[int, string]{
+			ittuple.T2[int, string]{Left: 2, Right: "c"},
+			ittuple.T2[int, string]{Left: 1, Right: "b"},
+			ittuple.T2[int, string]{Left: 0, Right: "a"},
+		}, sliceit.To(itlib.Reverse(it)))
+	})
+
+	t.Run("zip unsized", func(t *testing.T) {
+		it := itlib.Zip(rangeit.Count[int](), sliceit.In([]string{"a"}))
+
+		_, ok := it.(itkit.DoubleEndedIterator[itlib.Pair[int, string]])
+		assert.False(t, ok)
+	})
+
+	t.Run("enumerate", func(t *testing.T) {
+		it := rangeit.EnumerateFrom(10, sliceit.In([]string{"a", "b", "c"}))
+
+		_, ok := it.(itkit.DoubleEndedIterator[itlib.Pair[int, string]])
+		assert.True(t, ok)
+
+		assert.Equal(t, []itlib.Pair[int, string]{
+			ittuple.T2[int, string]{Left: 12, Right: "c"},
+			ittuple.T2[int, string]{Left: 11, Right: "b"},
+			ittuple.T2[int, string]{Left: 10, Right: "a"},
+		}, sliceit.To(itlib.Reverse(it)))
+	})
+}
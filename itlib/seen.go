@@ -0,0 +1,138 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"container/list"
+	"hash/maphash"
+	"math"
+)
+
+// SeenSet records the keys seen by the iterators returned by
+// [UniqueWith].
+type SeenSet[K any] interface {
+	// Insert records the key k and reports whenever k has not
+	// been recorded before.
+	Insert(k K) bool
+}
+
+// mapSet is an exact [SeenSet] remembering all keys.
+type mapSet[K comparable] map[K]struct{}
+
+// Insert implements the [SeenSet.Insert] interface.
+func (s mapSet[K]) Insert(k K) bool {
+	if _, ok := s[k]; ok {
+		return false
+	}
+	s[k] = struct{}{}
+	return true
+}
+
+// HashFn computes a hash of a key.
+type HashFn[K any] func(k K) uint64
+
+var hashSeed = maphash.MakeSeed()
+
+// HashString is a [HashFn] for strings.
+func HashString(s string) uint64 { return maphash.String(hashSeed, s) }
+
+// HashBytes is a [HashFn] for byte slices.
+func HashBytes(b []byte) uint64 { return maphash.Bytes(hashSeed, b) }
+
+// BloomSet is an approximate [SeenSet] backed by a Bloom filter,
+// using a fixed amount of memory regardless of the number of keys.
+//
+// A BloomSet might report keys never recorded before as seen, the
+// rate of such false positives is bound by the rate given to
+// [NewBloomSet] as long as no more keys are recorded than expected.
+type BloomSet[K any] struct {
+	hash HashFn[K]
+	bits []uint64
+	m, k uint64
+}
+
+// Ensure BloomSet conforms to the SeenSet protocol.
+var _ SeenSet[string] = &BloomSet[string]{}
+
+// Insert implements the [SeenSet.Insert] interface.
+func (s *BloomSet[K]) Insert(k K) (added bool) {
+	h := s.hash(k)
+
+	// Derive all hashes from two halves of h as described in
+	// "Less Hashing, Same Performance" by Kirsch and Mitzenmacher.
+	h1, h2 := h&math.MaxUint32, h>>32|1
+	for i := uint64(0); i < s.k; i++ {
+		bit := (h1 + i*h2) % s.m
+		if mask := uint64(1) << (bit % 64); s.bits[bit/64]&mask == 0 {
+			s.bits[bit/64] |= mask
+			added = true
+		}
+	}
+	return added
+}
+
+// NewBloomSet returns a [BloomSet] sized for n keys with a false
+// positive rate of p, hashing keys with the given [HashFn] hash.
+func NewBloomSet[K any](n int, p float64, hash HashFn[K]) *BloomSet[K] {
+	n, p = max(n, 1), min(max(p, 1e-12), 0.5)
+
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(n)*math.Ln2))
+	return &BloomSet[K]{
+		hash: hash,
+		bits: make([]uint64, (uint64(m)+63)/64),
+		m:    uint64(m),
+		k:    uint64(k),
+	}
+}
+
+// LRUSet is an approximate [SeenSet] remembering a bounded number of
+// the most recently seen keys.
+//
+// A LRUSet never reports keys not recorded before as seen, but
+// forgets the least recently seen keys once full, reporting them as
+// not seen again.
+type LRUSet[K comparable] struct {
+	size  int
+	order *list.List
+	index map[K]*list.Element
+}
+
+// Ensure LRUSet conforms to the SeenSet protocol.
+var _ SeenSet[string] = &LRUSet[string]{}
+
+// Insert implements the [SeenSet.Insert] interface.
+func (s *LRUSet[K]) Insert(k K) bool {
+	if e, ok := s.index[k]; ok {
+		s.order.MoveToFront(e)
+		return false
+	}
+
+	if s.order.Len() >= s.size {
+		e := s.order.Back()
+		delete(s.index, s.order.Remove(e).(K))
+	}
+	s.index[k] = s.order.PushFront(k)
+	return true
+}
+
+// NewLRUSet returns a [LRUSet] remembering up to size keys.
+func NewLRUSet[K comparable](size int) *LRUSet[K] {
+	return &LRUSet[K]{
+		size:  max(size, 1),
+		order: list.New(),
+		index: make(map[K]*list.Element, size),
+	}
+}
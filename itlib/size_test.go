@@ -0,0 +1,115 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/iters/funcit"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/iters/valit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func TestSizeHint(t *testing.T) {
+	unknown := funcit.PullFn(func() (int, bool) { return 0, false })
+
+	tt := []struct {
+		name   string
+		it     itkit.Iterator[int]
+		wanted itkit.SizeHint
+	}{
+		{"unknown", unknown, itkit.UnknownSize},
+		{"empty", itlib.Empty[int](), itkit.ExactSize(0)},
+		{"slice", sliceit.In([]int{1, 2, 3}), itkit.ExactSize(3)},
+		{"range", rangeit.RangeStep(0, 10, 3), itkit.ExactSize(4)},
+		{"count", rangeit.Count[int](), itkit.InfiniteSize},
+		{"fill", valit.Fill(1), itkit.InfiniteSize},
+		{"map", itlib.Map(rangeit.Range(5), func(v int) int { return v }), itkit.ExactSize(5)},
+		{"filter", itlib.Filter(rangeit.Range(5), func(v int) bool { return true }), itkit.SizeHint{Upper: 5}},
+		{"filter-infinite", itlib.Filter(valit.Fill(1), func(v int) bool { return true }), itkit.UnknownSize},
+		{"limit", itlib.Limit(3, rangeit.Range(5)), itkit.ExactSize(3)},
+		{"limit-short", itlib.Limit(7, rangeit.Range(5)), itkit.ExactSize(5)},
+		{"limit-infinite", itlib.Limit(7, rangeit.Count[int]()), itkit.ExactSize(7)},
+		{"limit-unknown", itlib.Limit(7, unknown), itkit.SizeHint{Upper: 7}},
+		{"limit-huge", itlib.Limit(math.MaxUint, rangeit.Count[int]()), itkit.ExactSize(math.MaxInt)},
+		{"takewhile", itlib.TakeWhile(rangeit.Range(5), func(v int) bool { return true }), itkit.SizeHint{Upper: 5}},
+		{"cycle", itlib.Cycle(rangeit.Range(2)), itkit.InfiniteSize},
+		{"cycle-empty", itlib.Cycle(itlib.Empty[int]()), itkit.ExactSize(0)},
+		{"cycle-unknown", itlib.Cycle(unknown), itkit.UnknownSize},
+		{"chain", itlib.ChainV(rangeit.Range(2), rangeit.Range(3)), itkit.ExactSize(5)},
+		{"chain-infinite", itlib.ChainV(rangeit.Range(2), rangeit.Count[int]()), itkit.InfiniteSize},
+		{"chain-unknown", itlib.ChainV(rangeit.Range(2), unknown), itkit.SizeHint{Lower: 2, Upper: -1}},
+		{"chain-iterator", itlib.ChainI(sliceit.In([]itkit.Iterator[int]{rangeit.Range(2)})), itkit.UnknownSize},
+		{"chain-empty", itlib.ChainV[int](), itkit.ExactSize(0)},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wanted, itkit.SizeHintOf(tc.it))
+		})
+	}
+
+	t.Run("zip", func(t *testing.T) {
+		it := itlib.Zip(rangeit.Range(5), sliceit.In([]string{"a", "b"}))
+		assert.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it))
+
+		it = itlib.Zip(rangeit.Count[int](), sliceit.In([]string{"a", "b"}))
+		assert.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it))
+
+		it = itlib.Zip(rangeit.Count[int](), valit.Fill("a"))
+		assert.Equal(t, itkit.InfiniteSize, itkit.SizeHintOf(it))
+	})
+
+	t.Run("chain-progress", func(t *testing.T) {
+		it := itlib.ChainV(rangeit.Range(2), rangeit.Range(3))
+		itlib.Drop(3, it)
+		assert.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it))
+	})
+
+	t.Run("peek", func(t *testing.T) {
+		it := itlib.Peek(rangeit.Range(3))
+		it.Peek()
+		assert.Equal(t, itkit.ExactSize(3), itkit.SizeHintOf(it.Iter()))
+		it.Next()
+		assert.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it.Iter()))
+	})
+
+	t.Run("chunk", func(t *testing.T) {
+		it := itlib.Chunk(2, rangeit.Range(5))
+		assert.Equal(t, itkit.ExactSize(3), itkit.SizeHintOf(it))
+
+		it.Next()
+		assert.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it))
+		assert.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it.Value()))
+	})
+}
+
+func TestSizeHint_Infinite(t *testing.T) {
+	assert.PanicsWithError(t, "sliceit: "+itkit.ErrInfinite.Error(), func() {
+		sliceit.To(itlib.Cycle(rangeit.Range(3)))
+	})
+
+	_, err := sliceit.ToErr(itlib.Map(rangeit.Count[int](), func(v int) int { return v }))
+	assert.ErrorIs(t, err, itkit.ErrInfinite)
+
+	_, err = sliceit.ToErr(itlib.ChainV(rangeit.Count[int]()))
+	assert.ErrorIs(t, err, itkit.ErrInfinite)
+}
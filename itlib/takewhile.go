@@ -54,6 +54,24 @@ func (it *TakeWhileIterator[T]) Value() (v T) {
 	return it.src.Value()
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *TakeWhileIterator[T]) Err() error {
+	return itkit.Err(it.src)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *TakeWhileIterator[T]) Close() error {
+	return itkit.Close(it.src)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *TakeWhileIterator[T]) SizeHint() itkit.SizeHint {
+	if it.stopped {
+		return itkit.ExactSize(0)
+	}
+	return itkit.SizeHintOf(it.src).AtMost()
+}
+
 // TakeWhile returns a new [TakeWhileIterator] value.
 func TakeWhile[T any](src itkit.Iterator[T], fn TakeWhileFn[T]) itkit.Iterator[T] {
 	return &TakeWhileIterator[T]{src: src, fn: fn}
@@ -28,6 +28,27 @@ type teeNode[T any] struct {
 type teeState[T any] struct {
 	mx  sync.RWMutex
 	src itkit.Iterator[T]
+	err error
+
+	// refs counts the TeeIterator instances not closed yet.
+	refs int
+}
+
+func (st *teeState[T]) acquire() {
+	st.mx.Lock()
+	defer st.mx.Unlock()
+
+	st.refs += 1
+}
+
+func (st *teeState[T]) release() error {
+	st.mx.Lock()
+	defer st.mx.Unlock()
+
+	if st.refs -= 1; st.refs > 0 {
+		return nil
+	}
+	return itkit.Close(st.src)
 }
 
 func (st *teeState[T]) nextLocked(cur *teeNode[T]) (*teeNode[T], bool) {
@@ -44,6 +65,8 @@ func (st *teeState[T]) nextRW(cur *teeNode[T]) (n *teeNode[T], ok bool) {
 
 	if st.src.Next() {
 		cur.next = &teeNode[T]{data: st.src.Value()}
+	} else {
+		st.err = itkit.Err(st.src)
 	}
 	return cur.next, cur.next != nil
 }
@@ -77,6 +100,9 @@ func (st *teeState[T]) next(cur *teeNode[T]) (n *teeNode[T], ok bool) {
 // will prevent the [TeeIterator] from seeing items retrieved
 // elsewhere.  Use copies of the [TeeIterator] instead.
 //
+// The source iterator is closed once all [TeeIterator] instances
+// sharing it have been closed.
+//
 // All [TeeIterator] instances are safe to use in goroutines.
 type TeeIterator[T any] struct {
 	st  *teeState[T]
@@ -88,6 +114,10 @@ var _ itkit.Iterator[struct{}] = &TeeIterator[struct{}]{}
 
 // Next implements the [itkit.Iterator.Next] interface.
 func (it *TeeIterator[T]) Next() bool {
+	if it.cur == nil {
+		// The iterator has been closed.
+		return false
+	}
 	if n, ok := it.st.next(it.cur); ok {
 		it.cur = n
 		return true
@@ -101,6 +131,25 @@ func (it *TeeIterator[T]) Value() T {
 	return it.cur.data
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *TeeIterator[T]) Err() error {
+	it.st.mx.RLock()
+	defer it.st.mx.RUnlock()
+
+	return it.st.err
+}
+
+// Close detaches the [TeeIterator] instance from its siblings,
+// allowing the items not consumed by it to be freed, and closes the
+// source iterator if it was the last instance not closed yet.
+func (it *TeeIterator[T]) Close() error {
+	if it.cur == nil {
+		return nil
+	}
+	it.cur = nil
+	return it.st.release()
+}
+
 // Iter returns the [TeeIterator] as an [itkit.Iterator] value.
 func (it *TeeIterator[T]) Iter() itkit.Iterator[T] {
 	return it
@@ -109,14 +158,17 @@ func (it *TeeIterator[T]) Iter() itkit.Iterator[T] {
 // Copy copies the [TeeIterator] instance.
 func (it *TeeIterator[T]) Copy() *TeeIterator[T] {
 	c := new(TeeIterator[T])
-	*c = *it
+	if *c = *it; c.cur != nil {
+		c.st.acquire()
+	}
 	return c
 }
 
 func newTee[T any](src itkit.Iterator[T]) *TeeIterator[T] {
 	return &TeeIterator[T]{
 		st: &teeState[T]{
-			src: src,
+			src:  src,
+			refs: 1,
 		},
 		cur: &teeNode[T]{},
 	}
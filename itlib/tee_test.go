@@ -124,7 +124,7 @@ func TestTee(t *testing.T) {
 		l, _ := itlib.Tee(rangeit.Range(5))
 
 		// Advanced the tee iterator once.
-		asserter.Equal(0, itlib.HeadOrElse(l.Iter(), -1))
+		asserter.Equal(0, itlib.TakeOrElse(l.Iter(), -1))
 
 		// Create a copy of the tee iterator.
 		c := l.Copy()
@@ -132,11 +132,11 @@ func TestTee(t *testing.T) {
 
 		// Assert retrieving the next item from the clone
 		// yields the next value.
-		asserter.Equal(1, itlib.HeadOrElse(c.Iter(), -1))
+		asserter.Equal(1, itlib.TakeOrElse(c.Iter(), -1))
 
 		// Assert retrieving the next item from the original
 		// tee reader yields the same value.
-		asserter.Equal(1, itlib.HeadOrElse(l.Iter(), -1))
+		asserter.Equal(1, itlib.TakeOrElse(l.Iter(), -1))
 	})
 
 	t.Run("leak", func(t *testing.T) {
@@ -0,0 +1,173 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/itclock"
+)
+
+// ThrottleIterator represents an iterator yielding items from a
+// given source iterator no faster than allowed by a token bucket
+// and a minimum spacing between items.
+//
+// The iterator waits before advancing the source iterator, until
+// then the items are left in the source.  Waiting stops once the
+// context is done, the error of the context is reported by
+// [ThrottleIterator.Err] then.
+//
+// The configuration fields must not be changed once the first item
+// has been retrieved from the iterator.
+type ThrottleIterator[T any] struct {
+	// Rate is the number of items allowed per second on average,
+	// not limited if not positive.
+	Rate float64
+
+	// Burst is the number of items allowed in a row before Rate
+	// applies, defaulting to 1.
+	Burst int
+
+	// Spacing is the minimum time between two items.
+	Spacing time.Duration
+
+	// Clock tells the time, defaulting to [itclock.Real].
+	Clock itclock.Clock
+
+	// Sleep is used to wait, defaulting to [itclock.Sleep] on
+	// Clock.
+	Sleep itclock.SleepFn
+
+	// Source is the original source to yield items from.
+	Source itkit.Iterator[T]
+
+	ctx     context.Context
+	started bool
+	tokens  float64
+	last    time.Time
+	prev    time.Time
+	err     error
+}
+
+// Ensure ThrottleIterator conforms to the ErrIterator protocol.
+var _ itkit.ErrIterator[struct{}] = &ThrottleIterator[struct{}]{}
+
+func (it *ThrottleIterator[T]) burst() float64 {
+	return float64(max(it.Burst, 1))
+}
+
+func (it *ThrottleIterator[T]) sleep(d time.Duration) error {
+	if it.Sleep != nil {
+		return it.Sleep(it.ctx, d)
+	}
+	return itclock.Sleep(it.ctx, itclock.OrReal(it.Clock), d)
+}
+
+// delay refills the token bucket and returns the time left until the
+// next item is allowed.
+func (it *ThrottleIterator[T]) delay(now time.Time) (d time.Duration) {
+	if !it.started {
+		it.started, it.tokens = true, it.burst()
+	} else if elapsed := now.Sub(it.last); elapsed > 0 {
+		it.tokens = min(it.burst(), it.tokens+elapsed.Seconds()*it.Rate)
+	}
+	it.last = now
+
+	if it.Rate > 0 && it.tokens < 1 {
+		d = time.Duration(math.Ceil((1 - it.tokens) / it.Rate * float64(time.Second)))
+	}
+	if it.Spacing > 0 && !it.prev.IsZero() {
+		d = max(d, it.prev.Add(it.Spacing).Sub(now))
+	}
+	return d
+}
+
+func (it *ThrottleIterator[T]) wait() error {
+	if it.ctx == nil {
+		it.ctx = context.Background()
+	}
+	if err := it.ctx.Err(); err != nil {
+		return err
+	}
+
+	clk := itclock.OrReal(it.Clock)
+	for {
+		now := clk.Now()
+		d := it.delay(now)
+		if d <= 0 {
+			it.tokens, it.prev = it.tokens-1, now
+			return nil
+		}
+		if err := it.sleep(d); err != nil {
+			return err
+		}
+	}
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ThrottleIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.err = it.wait(); it.err != nil {
+		return false
+	}
+	return it.Source.Next()
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *ThrottleIterator[T]) Value() T {
+	return it.Source.Value()
+}
+
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *ThrottleIterator[T]) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return itkit.Err(it.Source)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *ThrottleIterator[T]) Close() error {
+	return itkit.Close(it.Source)
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *ThrottleIterator[T]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.Source).AtMost()
+}
+
+// Iter returns the [ThrottleIterator] as an [itkit.Iterator] value.
+func (it *ThrottleIterator[T]) Iter() itkit.Iterator[T] {
+	return it
+}
+
+// Throttle returns a new [ThrottleIterator] yielding up to rate items
+// per second on average from the given source iterator, allowing for
+// bursts of up to burst items, until the given context is done.
+func Throttle[T any](ctx context.Context, rate float64, burst int, src itkit.Iterator[T]) *ThrottleIterator[T] {
+	return &ThrottleIterator[T]{Rate: rate, Burst: burst, Source: src, ctx: ctx}
+}
+
+// Space returns a new [ThrottleIterator] yielding items from the
+// given source iterator at least gap apart, until the given context
+// is done.
+func Space[T any](ctx context.Context, gap time.Duration, src itkit.Iterator[T]) *ThrottleIterator[T] {
+	return &ThrottleIterator[T]{Spacing: gap, Source: src, ctx: ctx}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/itclock"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+// fakeSleeper advances a fake clock instead of sleeping, recording
+// the times items are yielded at.
+type fakeSleeper struct {
+	clk   *itclock.Fake
+	start time.Time
+}
+
+func newFakeSleeper() *fakeSleeper {
+	start := time.Unix(0, 0)
+	return &fakeSleeper{clk: itclock.NewFake(start), start: start}
+}
+
+func (s *fakeSleeper) Sleep(ctx context.Context, d time.Duration) error {
+	s.clk.Advance(d)
+	return ctx.Err()
+}
+
+func (s *fakeSleeper) install(it *itlib.ThrottleIterator[int]) {
+	it.Clock, it.Sleep = s.clk, s.Sleep
+}
+
+// times returns the offsets at which the items are yielded.
+func (s *fakeSleeper) times(it *itlib.ThrottleIterator[int]) (out []time.Duration) {
+	for it.Next() {
+		out = append(out, s.clk.Now().Sub(s.start))
+	}
+	return out
+}
+
+func TestThrottle(t *testing.T) {
+	ms := time.Millisecond
+
+	tt := []struct {
+		name   string
+		it     *itlib.ThrottleIterator[int]
+		wanted []time.Duration
+	}{
+		{
+			name:   "rate",
+			it:     itlib.Throttle(context.Background(), 10, 1, rangeit.Range(4)),
+			wanted: []time.Duration{0, 100 * ms, 200 * ms, 300 * ms},
+		},
+		{
+			name:   "burst",
+			it:     itlib.Throttle(context.Background(), 2, 3, rangeit.Range(6)),
+			wanted: []time.Duration{0, 0, 0, 500 * ms, 1000 * ms, 1500 * ms},
+		},
+		{
+			name:   "spacing",
+			it:     itlib.Space(context.Background(), 250*ms, rangeit.Range(3)),
+			wanted: []time.Duration{0, 250 * ms, 500 * ms},
+		},
+		{
+			name: "spacing burst",
+			it: &itlib.ThrottleIterator[int]{
+				Rate: 1, Burst: 3, Spacing: 100 * ms, Source: rangeit.Range(5),
+			},
+			wanted: []time.Duration{0, 100 * ms, 200 * ms, 1000 * ms, 2000 * ms},
+		},
+		{
+			name:   "unlimited",
+			it:     &itlib.ThrottleIterator[int]{Source: rangeit.Range(3)},
+			wanted: []time.Duration{0, 0, 0},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newFakeSleeper()
+			s.install(tc.it)
+
+			assert.Equal(t, tc.wanted, s.times(tc.it))
+			assert.NoError(t, tc.it.Err())
+		})
+	}
+}
+
+func TestThrottle_Refill(t *testing.T) {
+	s := newFakeSleeper()
+	it := itlib.Throttle(context.Background(), 1, 2, rangeit.Range(10))
+	s.install(it)
+
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+
+	// Idle time refills the bucket up to the burst size.
+	s.clk.Advance(time.Hour)
+	start := s.clk.Now()
+	assert.True(t, it.Next())
+	assert.True(t, it.Next())
+	assert.Equal(t, start, s.clk.Now())
+
+	assert.True(t, it.Next())
+	assert.Equal(t, start.Add(time.Second), s.clk.Now())
+}
+
+func TestThrottle_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := itclock.NewFake(time.Unix(0, 0))
+	src := ittest.Closing(10)
+	it := itlib.Throttle[int](ctx, 1, 1, src)
+	it.Clock = clk
+
+	assert.True(t, it.Next())
+
+	// Cancel the context while the iterator waits on the clock.
+	go func() {
+		clk.BlockUntil(1)
+		cancel()
+	}()
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+	assert.False(t, it.Next())
+	assert.Equal(t, 0, clk.Timers())
+
+	assert.NoError(t, it.Close())
+	assert.Equal(t, 1, src.Closed)
+}
+
+func TestThrottle_Clock(t *testing.T) {
+	// Without a sleeper the iterator waits on the clock.
+	clk := itclock.NewFake(time.Unix(0, 0))
+	it := itlib.Space(context.Background(), time.Minute, rangeit.Range(2))
+	it.Clock = clk
+
+	assert.True(t, it.Next())
+
+	go func() {
+		clk.BlockUntil(1)
+		clk.Advance(time.Minute)
+	}()
+	assert.True(t, it.Next())
+	assert.Equal(t, time.Unix(60, 0), clk.Now())
+}
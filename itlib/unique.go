@@ -0,0 +1,71 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"github.com/0x5a17ed/itkit"
+)
+
+// UniqueWith returns an Iterator yielding the items from the given
+// iterator whose key as computed by the given [KeyFn] fn has not
+// been recorded in the given [SeenSet] seen before.
+func UniqueWith[T, K any](it itkit.Iterator[T], fn KeyFn[T, K], seen SeenSet[K]) itkit.Iterator[T] {
+	return &FilterIter[T]{it: it, fn: func(v T) bool { return seen.Insert(fn(v)) }}
+}
+
+// UniqueBy returns an Iterator yielding the items from the given
+// iterator whose key as computed by the given [KeyFn] fn has not
+// been seen before.
+//
+// All keys seen are kept in memory, see [UniqueWith] for bounding
+// the memory used.
+func UniqueBy[T any, K comparable](it itkit.Iterator[T], fn KeyFn[T, K]) itkit.Iterator[T] {
+	return UniqueWith[T, K](it, fn, mapSet[K]{})
+}
+
+// Unique returns an Iterator yielding the items from the given
+// iterator the first time they appear.
+//
+// All items seen are kept in memory, see [UniqueWith] for bounding
+// the memory used.
+func Unique[T comparable](it itkit.Iterator[T]) itkit.Iterator[T] {
+	return UniqueBy(it, identity[T])
+}
+
+// DedupConsecutiveBy returns an Iterator yielding the items from the
+// given iterator whose key as computed by the given [KeyFn] fn
+// differs from the key of the preceding item.
+func DedupConsecutiveBy[T any, K comparable](it itkit.Iterator[T], fn KeyFn[T, K]) itkit.Iterator[T] {
+	var (
+		last K
+		has  bool
+	)
+	return &FilterIter[T]{it: it, fn: func(v T) bool {
+		k := fn(v)
+		if has && k == last {
+			return false
+		}
+		last, has = k, true
+		return true
+	}}
+}
+
+// DedupConsecutive returns an Iterator yielding the items from the
+// given iterator collapsing runs of equal items into a single item.
+func DedupConsecutive[T comparable](it itkit.Iterator[T]) itkit.Iterator[T] {
+	return DedupConsecutiveBy(it, identity[T])
+}
+
+func identity[T any](v T) T { return v }
@@ -0,0 +1,102 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+func TestUnique(t *testing.T) {
+	assert.Empty(t, sliceit.To(itlib.Unique(itlib.Empty[int]())))
+
+	it := itlib.Unique(sliceit.In([]int{3, 1, 3, 2, 1, 4, 3}))
+	assert.Equal(t, itkit.SizeHint{Lower: 0, Upper: 7}, itkit.SizeHintOf(it))
+	assert.Equal(t, []int{3, 1, 2, 4}, sliceit.To(it))
+
+	// The returned iterator is not double-ended.
+	_, ok := it.(itkit.DoubleEndedIterator[int])
+	assert.False(t, ok)
+}
+
+func TestUniqueBy(t *testing.T) {
+	it := itlib.UniqueBy(sliceit.In([]string{"Go", "rust", "GO", "Rust", "zig"}), strings.ToLower)
+	assert.Equal(t, []string{"Go", "rust", "zig"}, sliceit.To(it))
+}
+
+func TestUnique_Err(t *testing.T) {
+	s, err := sliceit.ToErr(itlib.Unique(ittest.Failing(1, 1, 2)))
+	assert.ErrorIs(t, err, ittest.ErrBroken)
+	assert.Equal(t, []int{1, 2}, s)
+}
+
+func TestDedupConsecutive(t *testing.T) {
+	tt := []struct {
+		name   string
+		inp    []int
+		wanted []int
+	}{
+		{"empty", nil, nil},
+		{"single", []int{1}, []int{1}},
+		{"runs", []int{1, 1, 2, 2, 2, 1, 3, 3}, []int{1, 2, 1, 3}},
+		{"zero", []int{0, 0, 1}, []int{0, 1}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wanted, sliceit.To(itlib.DedupConsecutive(sliceit.In(tc.inp))))
+		})
+	}
+
+	it := itlib.DedupConsecutiveBy(sliceit.In([]string{"a", "A", "b", "a"}), strings.ToUpper)
+	assert.Equal(t, []string{"a", "b", "a"}, sliceit.To(it))
+}
+
+func TestUniqueWith_LRU(t *testing.T) {
+	seen := itlib.NewLRUSet[int](2)
+	it := itlib.UniqueWith(sliceit.In([]int{1, 2, 1, 3, 2, 1, 1}), identity[int], seen)
+
+	// Keys are forgotten once not among the 2 most recently seen.
+	assert.Equal(t, []int{1, 2, 3, 2, 1}, sliceit.To(it))
+}
+
+func TestUniqueWith_Bloom(t *testing.T) {
+	const n = 10_000
+
+	seen := itlib.NewBloomSet(n, 0.01, itlib.HashString)
+	src := itlib.Map(rangeit.Range(2*n), func(i int) string { return strconv.Itoa(i % n) })
+
+	// Every key repeated is dropped, false positives drop about
+	// 1% of the unique keys.
+	got := len(sliceit.To(itlib.UniqueWith(src, identity[string], seen)))
+	assert.LessOrEqual(t, got, n)
+	assert.Greater(t, got, n*97/100)
+}
+
+func TestBloomSet(t *testing.T) {
+	s := itlib.NewBloomSet(100, 0.001, itlib.HashBytes)
+
+	assert.True(t, s.Insert([]byte("lorem")))
+	assert.False(t, s.Insert([]byte("lorem")))
+	assert.True(t, s.Insert([]byte("ipsum")))
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"github.com/0x5a17ed/itkit"
+)
+
+func pairLeft[T1, T2 any](p Pair[T1, T2]) T1 {
+	l, _ := p.Values()
+	return l
+}
+
+func pairRight[T1, T2 any](p Pair[T1, T2]) T2 {
+	_, r := p.Values()
+	return r
+}
+
+// Unzip returns two iterators yielding the left and the right
+// values of the pairs yielded by the given iterator respectively,
+// reversing the effect of [Zip].
+//
+// Both returned iterators advance the source iterator only when
+// needed and buffer the values not consumed by their sibling yet,
+// like a [TeeIterator] does.  They are safe to use in goroutines.
+//
+// The source iterator is closed once both returned iterators have
+// been closed.
+func Unzip[T1, T2 any](src itkit.Iterator[Pair[T1, T2]]) (itkit.Iterator[T1], itkit.Iterator[T2]) {
+	l, r := Tee(src)
+	return Map[Pair[T1, T2], T1](l, pairLeft[T1, T2]), Map[Pair[T1, T2], T2](r, pairRight[T1, T2])
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itlib"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// countingIterator counts the items retrieved from its source.
+type countingIterator[T any] struct {
+	itkit.Iterator[T]
+	pulled int
+}
+
+func (it *countingIterator[T]) Next() bool {
+	if !it.Iterator.Next() {
+		return false
+	}
+	it.pulled += 1
+	return true
+}
+
+func TestUnzip(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		l, r := itlib.Unzip(itlib.Empty[itlib.Pair[int, string]]())
+
+		assert.False(t, l.Next())
+		assert.False(t, r.Next())
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		l, r := itlib.Unzip(itlib.Zip(
+			sliceit.In([]string{"a", "b", "c"}),
+			sliceit.In([]int{17, 19, 23}),
+		))
+
+		assert.Equal(t, []string{"a", "b", "c"}, sliceit.To(l))
+		assert.Equal(t, []int{17, 19, 23}, sliceit.To(r))
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		src := &countingIterator[itlib.Pair[int, int]]{
+			Iterator: itlib.Zip(rangeit.Range(5), rangeit.Range(5)),
+		}
+		l, r := itlib.Unzip[int, int](src)
+
+		assert.True(t, l.Next())
+		assert.True(t, l.Next())
+		assert.Equal(t, 1, l.Value())
+		assert.Equal(t, 2, src.pulled)
+
+		assert.True(t, r.Next())
+		assert.Equal(t, 0, r.Value())
+		assert.Equal(t, 2, src.pulled)
+	})
+
+	t.Run("goroutines", func(t *testing.T) {
+		l, r := itlib.Unzip(itlib.Map(rangeit.Range(1000), func(v int) itlib.Pair[int, int] {
+			return ittuple.NewT2(v, -v)
+		}))
+
+		var (
+			wg     sync.WaitGroup
+			ls, rs []int
+		)
+		wg.Add(2)
+		go func() { defer wg.Done(); ls = sliceit.To(l) }()
+		go func() { defer wg.Done(); rs = sliceit.To(r) }()
+		wg.Wait()
+
+		assert.Len(t, ls, 1000)
+		assert.Len(t, rs, 1000)
+		for i := range ls {
+			assert.Equal(t, ls[i], -rs[i])
+		}
+	})
+
+	t.Run("err", func(t *testing.T) {
+		l, r := itlib.Unzip(itlib.Zip(ittest.Failing(1, 2), rangeit.Range(5)))
+
+		ls, err := sliceit.ToErr(l)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+		assert.Equal(t, []int{1, 2}, ls)
+
+		rs, err := sliceit.ToErr(r)
+		assert.ErrorIs(t, err, ittest.ErrBroken)
+		assert.Equal(t, []int{0, 1}, rs)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		src := ittest.Closing(3)
+		l, r := itlib.Unzip(itlib.Zip[int, int](src, rangeit.Range(3)))
+
+		assert.NoError(t, itkit.Close(l))
+		assert.Equal(t, 0, src.Closed)
+		assert.NoError(t, itkit.Close(r))
+		assert.Equal(t, 1, src.Closed)
+	})
+}
@@ -23,26 +23,55 @@ type windowSubIterator[T any] struct{ parent *WindowIterator[T] }
 func (it windowSubIterator[T]) Next() bool { return it.parent.windowNext() }
 func (it windowSubIterator[T]) Value() T   { return it.parent.windowValue() }
 
+// WindowTail specifies how a [WindowIterator] deals with the last
+// window not filled up completely by the source iterator.
+type WindowTail int
+
+const (
+	// PadTail fills the missing items of the last window up with
+	// the FillValue.
+	PadTail WindowTail = iota
+
+	// TruncateTail shortens the last window to the items
+	// available.
+	TruncateTail
+
+	// DropTail drops the last window.
+	DropTail
+)
+
 // WindowIterator is an iterator providing a sliding window over the
 // given source iterator as a sub-iterator.
+//
+// The sub-iterator yields the items of the window current at the
+// time the sub-iterator is advanced, use [WindowIterator.Slice] or
+// [WindowIterator.Slices] to retain windows.
 type WindowIterator[T any] struct {
 	// Size specifies the window size.
 	Size uint
 
 	// Steps specifies the number of items the iterator will
-	// advance for each call of the Next function.
+	// advance for each call of the Next function.  Windows do
+	// not overlap if Steps is equal to Size, items between the
+	// windows are skipped if Steps is larger than Size.
 	Steps uint
 
 	// FillValue is used to supplement missing items in case the
 	// window is larger than the iterable can yield items.
 	FillValue T
 
+	// Tail specifies how the last window is dealt with if the
+	// source iterator is exhausted before filling it up.
+	Tail WindowTail
+
 	// Source is the original source to yield items from.
 	Source itkit.Iterator[T]
 
 	window []T
 	offset uint
+	length uint
 	index  uint
+	done   bool
 	cur    T
 }
 
@@ -50,7 +79,7 @@ type WindowIterator[T any] struct {
 var _ itkit.Iterator[itkit.Iterator[struct{}]] = &WindowIterator[struct{}]{}
 
 func (it *WindowIterator[T]) windowNext() (ok bool) {
-	if ok = it.index < it.Size; ok {
+	if ok = it.index < it.length; ok {
 		it.cur, it.index = it.window[(it.offset+it.index)%it.Size], it.index+1
 	}
 	return
@@ -60,38 +89,82 @@ func (it *WindowIterator[T]) windowValue() T {
 	return it.cur
 }
 
-func (it *WindowIterator[T]) fillWindow(n uint) bool {
-	if !it.Source.Next() {
-		return false
+// fillWindow reads up to n items from the source iterator into the
+// window, starting at position pos of the window, and returns the
+// number of items read.
+func (it *WindowIterator[T]) fillWindow(pos, n uint) (read uint) {
+	for ; read < n; read++ {
+		if !it.Source.Next() {
+			it.done = true
+			break
+		}
+		it.window[(pos+read)%it.Size] = it.Source.Value()
 	}
+	return read
+}
 
-	it.window[it.offset] = it.Source.Value()
-	for i := uint(1); i < n; i++ {
-		j := (it.offset + i) % it.Size
-		if it.Source.Next() {
-			it.window[j] = it.Source.Value()
-		} else {
-			it.window[j] = it.FillValue
+// skip skips n items of the source iterator.
+func (it *WindowIterator[T]) skip(n uint) bool {
+	for i := uint(0); i < n; i++ {
+		if !it.Source.Next() {
+			it.done = true
+			return false
 		}
 	}
-
 	return true
 }
 
-// Next implements the [itkit.Iterator.Next] interface.
-func (it *WindowIterator[T]) Next() (ok bool) {
-	if it.window == nil {
-		it.window = make([]T, it.Size)
-		if ok = it.fillWindow(it.Size); !ok {
-			it.window = nil
+// finish completes a window whose last missing items were not
+// available from the source iterator.
+func (it *WindowIterator[T]) finish(missing uint) bool {
+	if missing == 0 {
+		return true
+	}
+
+	switch it.Tail {
+	case DropTail:
+		return false
+	case TruncateTail:
+		it.length -= missing
+	default:
+		for i := it.length - missing; i < it.length; i++ {
+			it.window[(it.offset+i)%it.Size] = it.FillValue
 		}
-		return
+	}
+	return true
+}
+
+func (it *WindowIterator[T]) advance() bool {
+	if it.done || it.Size == 0 {
+		return false
 	}
 
-	if ok = it.fillWindow(it.Steps); ok {
-		it.offset, it.index = (it.offset+it.Steps)%it.Size, 0
+	steps := max(it.Steps, 1)
+	if it.window == nil || steps >= it.Size {
+		// Start over with a fresh window.
+		if it.window == nil {
+			it.window = make([]T, it.Size)
+		} else if !it.skip(steps - it.Size) {
+			return false
+		}
+
+		it.offset, it.length = 0, it.Size
+		read := it.fillWindow(0, it.Size)
+		return read > 0 && it.finish(it.Size-read)
 	}
 
+	// Slide the window, keeping the overlapping items.
+	read := it.fillWindow(it.offset+it.length, steps)
+	it.offset, it.length = (it.offset+steps)%it.Size, it.Size
+	return read > 0 && it.finish(steps-read)
+}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *WindowIterator[T]) Next() (ok bool) {
+	it.index = 0
+	if ok = it.advance(); !ok {
+		it.done, it.length = true, 0
+	}
 	return
 }
 
@@ -100,12 +173,41 @@ func (it *WindowIterator[T]) Value() itkit.Iterator[T] {
 	return windowSubIterator[T]{parent: it}
 }
 
+// Err implements the [itkit.ErrIterator.Err] interface.
+func (it *WindowIterator[T]) Err() error {
+	return itkit.Err(it.Source)
+}
+
+// Close implements the [io.Closer] interface.
+func (it *WindowIterator[T]) Close() error {
+	return itkit.Close(it.Source)
+}
+
+// Slice returns a copy of the items in the current window.
+func (it *WindowIterator[T]) Slice() []T {
+	out := make([]T, it.length)
+	for i := range out {
+		out[i] = it.window[(it.offset+uint(i))%it.Size]
+	}
+	return out
+}
+
+// Slices returns an [itkit.Iterator] yielding copies of the windows
+// as slices, which remain valid once the [WindowIterator] advances.
+func (it *WindowIterator[T]) Slices() itkit.Iterator[[]T] {
+	return &MapIterator[itkit.Iterator[T], []T]{
+		it: it,
+		fn: func(itkit.Iterator[T]) []T { return it.Slice() },
+	}
+}
+
 // Iter returns the [WindowIterator] as an [itkit.Iterator] value.
 func (it *WindowIterator[T]) Iter() itkit.Iterator[itkit.Iterator[T]] {
 	return it
 }
 
-// Window returns a new [WindowIterator] value.
+// Window returns a new [WindowIterator] value, sliding the window by
+// one item at a time.
 func Window[T any](n uint, src itkit.Iterator[T]) itkit.Iterator[itkit.Iterator[T]] {
 	var zero T
 	return &WindowIterator[T]{
@@ -115,3 +217,16 @@ func Window[T any](n uint, src itkit.Iterator[T]) itkit.Iterator[itkit.Iterator[
 		Source:    src,
 	}
 }
+
+// TumblingWindow returns a new [WindowIterator] value yielding
+// consecutive windows of n items not overlapping.
+func TumblingWindow[T any](n uint, src itkit.Iterator[T]) *WindowIterator[T] {
+	return &WindowIterator[T]{Size: n, Steps: n, Source: src}
+}
+
+// HoppingWindow returns a new [WindowIterator] value yielding windows
+// of n items starting every step items, skipping the items between
+// windows if step is larger than n.
+func HoppingWindow[T any](n, step uint, src itkit.Iterator[T]) *WindowIterator[T] {
+	return &WindowIterator[T]{Size: n, Steps: step, Source: src}
+}
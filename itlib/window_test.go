@@ -102,3 +102,93 @@ func TestWindow(t *testing.T) {
 		})
 	}
 }
+
+func TestWindow_Modes(t *testing.T) {
+	type testCase struct {
+		name string
+		it   *itlib.WindowIterator[int]
+		want [][]int
+	}
+	tt := []testCase{
+		{
+			name: "zero size",
+			it:   &itlib.WindowIterator[int]{Steps: 1, Source: rangeit.Range(3)},
+			want: [][]int{},
+		},
+		{
+			name: "truncate",
+			it: &itlib.WindowIterator[int]{
+				Size: 3, Steps: 2, Tail: itlib.TruncateTail, Source: rangeit.RangeFrom(1, 7),
+			},
+			want: [][]int{{1, 2, 3}, {3, 4, 5}, {5, 6}},
+		},
+		{
+			name: "truncate short",
+			it: &itlib.WindowIterator[int]{
+				Size: 5, Steps: 1, Tail: itlib.TruncateTail, Source: rangeit.RangeFrom(1, 3),
+			},
+			want: [][]int{{1, 2}},
+		},
+		{
+			name: "drop",
+			it: &itlib.WindowIterator[int]{
+				Size: 3, Steps: 2, Tail: itlib.DropTail, Source: rangeit.RangeFrom(1, 7),
+			},
+			want: [][]int{{1, 2, 3}, {3, 4, 5}},
+		},
+		{
+			name: "drop short",
+			it: &itlib.WindowIterator[int]{
+				Size: 3, Steps: 1, Tail: itlib.DropTail, Source: rangeit.RangeFrom(1, 3),
+			},
+			want: [][]int{},
+		},
+		{
+			name: "tumbling",
+			it:   itlib.TumblingWindow(3, rangeit.RangeFrom(1, 9)),
+			want: [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 0}},
+		},
+		{
+			name: "tumbling exact",
+			it:   itlib.TumblingWindow(2, rangeit.RangeFrom(1, 5)),
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "hopping",
+			it:   itlib.HoppingWindow(2, 3, rangeit.RangeFrom(1, 10)),
+			want: [][]int{{1, 2}, {4, 5}, {7, 8}},
+		},
+		{
+			name: "hopping tail",
+			it:   itlib.HoppingWindow(2, 4, rangeit.RangeFrom(1, 10)),
+			want: [][]int{{1, 2}, {5, 6}, {9, 0}},
+		},
+		{
+			name: "hopping skipped tail",
+			it:   itlib.HoppingWindow(2, 4, rangeit.RangeFrom(1, 8)),
+			want: [][]int{{1, 2}, {5, 6}},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got := sliceit.To(tc.it.Slices())
+			if len(tc.want) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWindow_Slices(t *testing.T) {
+	it := itlib.Window(2, rangeit.Range(4)).(*itlib.WindowIterator[int])
+
+	// Slices remain valid once the iterator advances.
+	var got [][]int
+	for it.Next() {
+		got = append(got, it.Slice())
+	}
+	assert.Equal(t, [][]int{{0, 1}, {1, 2}, {2, 3}}, got)
+	assert.False(t, it.Next())
+}
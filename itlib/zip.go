@@ -15,6 +15,8 @@
 package itlib
 
 import (
+	"errors"
+
 	"github.com/0x5a17ed/itkit"
 	"github.com/0x5a17ed/itkit/ittuple"
 )
@@ -23,6 +25,7 @@ type ZipIterator[T1, T2 any] struct {
 	Left  itkit.Iterator[T1]
 	Right itkit.Iterator[T2]
 	cur   ittuple.T2[T1, T2]
+	err   error
 }
 
 type Pair[T1, T2 any] interface{ Values() (T1, T2) }
@@ -30,22 +33,85 @@ type Pair[T1, T2 any] interface{ Values() (T1, T2) }
 // Ensure ZipIterator conforms to the Iterator protocol.
 var _ itkit.Iterator[Pair[struct{}, struct{}]] = &ZipIterator[struct{}, struct{}]{}
 
-func (it *ZipIterator[T1, T2]) Next() (ok bool) {
-	if ok = it.Left.Next() && it.Right.Next(); ok {
-		it.cur = ittuple.T2[T1, T2]{it.Left.Value(), it.Right.Value()}
+func (it *ZipIterator[T1, T2]) Next() bool {
+	if !it.Left.Next() {
+		it.err = itkit.Err(it.Left)
+		return false
+	}
+	if !it.Right.Next() {
+		it.err = itkit.Err(it.Right)
+		return false
 	}
-	return
+	it.cur = ittuple.T2[T1, T2]{Left: it.Left.Value(), Right: it.Right.Value()}
+	return true
 }
 
 func (it *ZipIterator[T1, T2]) Value() Pair[T1, T2] {
 	return it.cur
 }
 
+// Close closes both source iterators, implementing the [io.Closer]
+// interface.
+func (it *ZipIterator[T1, T2]) Close() error {
+	return errors.Join(itkit.Close(it.Left), itkit.Close(it.Right))
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *ZipIterator[T1, T2]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.Left).Min(itkit.SizeHintOf(it.Right))
+}
+
+// Err returns the error reported by the first source iterator
+// that stopped the ZipIterator, if any.
+func (it *ZipIterator[T1, T2]) Err() error {
+	return it.err
+}
+
+// zipBackIterator is a ZipIterator over double-ended sources of
+// known size.
+type zipBackIterator[T1, T2 any] struct {
+	*ZipIterator[T1, T2]
+	left  itkit.DoubleEndedIterator[T1]
+	right itkit.DoubleEndedIterator[T2]
+}
+
+// NextBack implements the [itkit.DoubleEndedIterator.NextBack] interface.
+func (it zipBackIterator[T1, T2]) NextBack() bool {
+	// Trim the longer source first to align both ends.
+	l, _ := itkit.SizeHintOf[T1](it.left).Exact()
+	r, _ := itkit.SizeHintOf[T2](it.right).Exact()
+	for ; l > r && it.left.NextBack(); l-- {
+	}
+	for ; r > l && it.right.NextBack(); r-- {
+	}
+
+	if !it.left.NextBack() || !it.right.NextBack() {
+		return false
+	}
+	it.cur = ittuple.T2[T1, T2]{Left: it.left.Value(), Right: it.right.Value()}
+	return true
+}
+
+func exactSize[T any](it itkit.Iterator[T]) bool {
+	_, ok := itkit.SizeHintOf(it).Exact()
+	return ok
+}
+
 // Zip returns an iterator that aggregates elements from the given iterators.
 //
 // The returned iterator yield T2 values, where the i-th tuple contains
 // the i-th element from each of the argument sequences or iterables.
 // The iterator will stop when the shortest input iterable is exhausted.
+//
+// The returned iterator is double-ended if both given iterators are
+// double-ended and report their exact size.
 func Zip[T1, T2 any](it1 itkit.Iterator[T1], it2 itkit.Iterator[T2]) itkit.Iterator[Pair[T1, T2]] {
-	return &ZipIterator[T1, T2]{Left: it1, Right: it2}
+	z := &ZipIterator[T1, T2]{Left: it1, Right: it2}
+
+	l, lok := it1.(itkit.DoubleEndedIterator[T1])
+	r, rok := it2.(itkit.DoubleEndedIterator[T2])
+	if lok && rok && exactSize(it1) && exactSize(it2) {
+		return zipBackIterator[T1, T2]{ZipIterator: z, left: l, right: r}
+	}
+	return z
 }
@@ -19,6 +19,8 @@ import (
 
 	assertpkg "github.com/stretchr/testify/assert"
 
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/internal/ittest"
 	"github.com/0x5a17ed/itkit/iters/sliceit"
 	"github.com/0x5a17ed/itkit/itlib"
 	"github.com/0x5a17ed/itkit/ittuple"
@@ -56,3 +58,110 @@ func TestZip(t *testing.T) {
 		})
 	}
 }
+
+func TestZip3(t *testing.T) {
+	it := itlib.Zip3(
+		sliceit.In([]string{"a", "b", "c"}),
+		sliceit.In([]int{1, 2}),
+		sliceit.In([]bool{true, false, true}),
+	)
+
+	assertpkg.Equal(t, []ittuple.T3[string, int, bool]{
+		ittuple.NewT3("a", 1, true),
+		ittuple.NewT3("b", 2, false),
+	}, sliceit.To(it))
+}
+
+func TestZip6(t *testing.T) {
+	it := itlib.Zip6(
+		sliceit.In([]int{1, 2}),
+		sliceit.In([]int{3, 4}),
+		sliceit.In([]int{5, 6}),
+		sliceit.In([]int{7, 8}),
+		sliceit.In([]int{9, 10}),
+		sliceit.In([]string{"x", "y", "z"}),
+	)
+
+	assertpkg.Equal(t, itkit.ExactSize(2), itkit.SizeHintOf(it))
+	assertpkg.Equal(t, []ittuple.T6[int, int, int, int, int, string]{
+		ittuple.NewT6(1, 3, 5, 7, 9, "x"),
+		ittuple.NewT6(2, 4, 6, 8, 10, "y"),
+	}, sliceit.To(it))
+}
+
+func TestZip3_Err(t *testing.T) {
+	it := itlib.Zip3(sliceit.In([]int{1, 2, 3}), ittest.Failing(4), sliceit.In([]int{5, 6, 7}))
+
+	s, err := sliceit.ToErr(it)
+	assertpkg.ErrorIs(t, err, ittest.ErrBroken)
+	assertpkg.Equal(t, []ittuple.T3[int, int, int]{ittuple.NewT3(1, 4, 5)}, s)
+}
+
+func TestZipLongest(t *testing.T) {
+	type args struct {
+		left  []string
+		right []int
+	}
+	tt := []struct {
+		name    string
+		args    args
+		want    []itlib.Pair[string, int]
+		present [][2]bool
+	}{
+		{"empty", args{}, nil, nil},
+		{"equal", args{
+			left:  []string{"a", "b"},
+			right: []int{17, 19},
+		}, []itlib.Pair[string, int]{
+			ittuple.NewT2("a", 17), ittuple.NewT2("b", 19),
+		}, [][2]bool{{true, true}, {true, true}}},
+		{"left short", args{
+			left:  []string{"a"},
+			right: []int{17, 19, 23},
+		}, []itlib.Pair[string, int]{
+			ittuple.NewT2("a", 17), ittuple.NewT2("-", 19), ittuple.NewT2("-", 23),
+		}, [][2]bool{{true, true}, {false, true}, {false, true}}},
+		{"right short", args{
+			left:  []string{"a", "b", "c"},
+			right: []int{17},
+		}, []itlib.Pair[string, int]{
+			ittuple.NewT2("a", 17), ittuple.NewT2("b", -1), ittuple.NewT2("c", -1),
+		}, [][2]bool{{true, true}, {true, false}, {true, false}}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			it := itlib.ZipLongestFill(sliceit.In(tc.args.left), sliceit.In(tc.args.right), "-", -1)
+
+			var (
+				got     []itlib.Pair[string, int]
+				present [][2]bool
+			)
+			for it.Next() {
+				l, r := it.Present()
+				got = append(got, it.Value())
+				present = append(present, [2]bool{l, r})
+			}
+
+			assertpkg.Equal(t, tc.want, got)
+			assertpkg.Equal(t, tc.present, present)
+			assertpkg.NoError(t, it.Err())
+		})
+	}
+}
+
+func TestZipLongest_SizeHint(t *testing.T) {
+	it := itlib.ZipLongest(sliceit.In([]int{1, 2, 3}), sliceit.In([]int{4}))
+	assertpkg.Equal(t, itkit.ExactSize(3), itkit.SizeHintOf[itlib.Pair[int, int]](it))
+
+	it.Next()
+	it.Next()
+	assertpkg.Equal(t, itkit.ExactSize(1), itkit.SizeHintOf[itlib.Pair[int, int]](it))
+}
+
+func TestZipLongest_Err(t *testing.T) {
+	it := itlib.ZipLongest(sliceit.In([]int{1, 2, 3}), ittest.Failing(4))
+
+	s, err := sliceit.ToErr[itlib.Pair[int, int]](it)
+	assertpkg.ErrorIs(t, err, ittest.ErrBroken)
+	assertpkg.Equal(t, []itlib.Pair[int, int]{ittuple.NewT2(1, 4)}, s)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"errors"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// ZipLongestIterator is an iterator aggregating the items of two
+// source iterators until both of them are exhausted. Items missing
+// from the shorter source are substituted by a fill value.
+type ZipLongestIterator[T1, T2 any] struct {
+	Left  itkit.Iterator[T1]
+	Right itkit.Iterator[T2]
+
+	// FillLeft is yielded in place of the items missing from Left.
+	FillLeft T1
+
+	// FillRight is yielded in place of the items missing from Right.
+	FillRight T2
+
+	leftDone, rightDone bool
+	leftOk, rightOk     bool
+
+	cur ittuple.T2[T1, T2]
+	err error
+}
+
+// Ensure ZipLongestIterator conforms to the Iterator protocol.
+var _ itkit.Iterator[Pair[struct{}, struct{}]] = &ZipLongestIterator[struct{}, struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *ZipLongestIterator[T1, T2]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.cur = ittuple.T2[T1, T2]{Left: it.FillLeft, Right: it.FillRight}
+
+	it.leftOk = !it.leftDone && it.Left.Next()
+	if it.leftOk {
+		it.cur.Left = it.Left.Value()
+	} else if !it.leftDone {
+		it.leftDone = true
+		if it.err = itkit.Err(it.Left); it.err != nil {
+			return false
+		}
+	}
+
+	it.rightOk = !it.rightDone && it.Right.Next()
+	if it.rightOk {
+		it.cur.Right = it.Right.Value()
+	} else if !it.rightDone {
+		it.rightDone = true
+		if it.err = itkit.Err(it.Right); it.err != nil {
+			return false
+		}
+	}
+
+	return it.leftOk || it.rightOk
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *ZipLongestIterator[T1, T2]) Value() Pair[T1, T2] {
+	return it.cur
+}
+
+// Present reports whenever the left and the right side of the
+// current value originate from their source iterator rather than
+// being fill values.
+func (it *ZipLongestIterator[T1, T2]) Present() (left, right bool) {
+	return it.leftOk, it.rightOk
+}
+
+// Err returns the error reported by the first source iterator
+// that failed, if any.
+func (it *ZipLongestIterator[T1, T2]) Err() error {
+	return it.err
+}
+
+// Close closes both source iterators, implementing the [io.Closer]
+// interface.
+func (it *ZipLongestIterator[T1, T2]) Close() error {
+	return errors.Join(itkit.Close(it.Left), itkit.Close(it.Right))
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *ZipLongestIterator[T1, T2]) SizeHint() itkit.SizeHint {
+	l, r := itkit.ExactSize(0), itkit.ExactSize(0)
+	if !it.leftDone {
+		l = itkit.SizeHintOf(it.Left)
+	}
+	if !it.rightDone {
+		r = itkit.SizeHintOf(it.Right)
+	}
+	return l.Max(r)
+}
+
+// ZipLongest returns an iterator that aggregates elements from the
+// given iterators, like [Zip], but continues until both iterators
+// are exhausted.
+//
+// Items missing from the shorter iterator are substituted by the
+// zero value of their type, use [ZipLongestFill] to provide fill
+// values or [ZipLongestIterator.Present] to tell them apart.
+func ZipLongest[T1, T2 any](it1 itkit.Iterator[T1], it2 itkit.Iterator[T2]) *ZipLongestIterator[T1, T2] {
+	return &ZipLongestIterator[T1, T2]{Left: it1, Right: it2}
+}
+
+// ZipLongestFill returns an iterator like [ZipLongest] yielding
+// fill1 and fill2 in place of the items missing from it1 and it2
+// respectively.
+func ZipLongestFill[T1, T2 any](it1 itkit.Iterator[T1], it2 itkit.Iterator[T2], fill1 T1, fill2 T2) *ZipLongestIterator[T1, T2] {
+	return &ZipLongestIterator[T1, T2]{Left: it1, Right: it2, FillLeft: fill1, FillRight: fill2}
+}
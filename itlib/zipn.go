@@ -0,0 +1,310 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itlib
+
+import (
+	"errors"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// Zip3Iterator is an iterator aggregating the items of 3 source
+// iterators into [ittuple.T3] values.
+type Zip3Iterator[TA, TB, TC any] struct {
+	A itkit.Iterator[TA]
+	B itkit.Iterator[TB]
+	C itkit.Iterator[TC]
+
+	cur ittuple.T3[TA, TB, TC]
+	err error
+}
+
+// Ensure Zip3Iterator conforms to the Iterator protocol.
+var _ itkit.Iterator[ittuple.T3[struct{}, struct{}, struct{}]] = &Zip3Iterator[struct{}, struct{}, struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *Zip3Iterator[TA, TB, TC]) Next() bool {
+	if !it.A.Next() {
+		it.err = itkit.Err(it.A)
+		return false
+	}
+	if !it.B.Next() {
+		it.err = itkit.Err(it.B)
+		return false
+	}
+	if !it.C.Next() {
+		it.err = itkit.Err(it.C)
+		return false
+	}
+	it.cur = ittuple.T3[TA, TB, TC]{A: it.A.Value(), B: it.B.Value(), C: it.C.Value()}
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *Zip3Iterator[TA, TB, TC]) Value() ittuple.T3[TA, TB, TC] {
+	return it.cur
+}
+
+// Err returns the error reported by the first source iterator
+// that stopped the Zip3Iterator, if any.
+func (it *Zip3Iterator[TA, TB, TC]) Err() error {
+	return it.err
+}
+
+// Close closes all source iterators, implementing the [io.Closer]
+// interface.
+func (it *Zip3Iterator[TA, TB, TC]) Close() error {
+	return errors.Join(itkit.Close(it.A), itkit.Close(it.B), itkit.Close(it.C))
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *Zip3Iterator[TA, TB, TC]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.A).
+		Min(itkit.SizeHintOf(it.B)).
+		Min(itkit.SizeHintOf(it.C))
+}
+
+// Zip3 returns an iterator that aggregates elements from the given
+// iterators into [ittuple.T3] values, stopping when the shortest
+// input iterator is exhausted.
+func Zip3[TA, TB, TC any](a itkit.Iterator[TA], b itkit.Iterator[TB], c itkit.Iterator[TC]) itkit.Iterator[ittuple.T3[TA, TB, TC]] {
+	return &Zip3Iterator[TA, TB, TC]{A: a, B: b, C: c}
+}
+
+// Zip4Iterator is an iterator aggregating the items of 4 source
+// iterators into [ittuple.T4] values.
+type Zip4Iterator[TA, TB, TC, TD any] struct {
+	A itkit.Iterator[TA]
+	B itkit.Iterator[TB]
+	C itkit.Iterator[TC]
+	D itkit.Iterator[TD]
+
+	cur ittuple.T4[TA, TB, TC, TD]
+	err error
+}
+
+// Ensure Zip4Iterator conforms to the Iterator protocol.
+var _ itkit.Iterator[ittuple.T4[struct{}, struct{}, struct{}, struct{}]] = &Zip4Iterator[struct{}, struct{}, struct{}, struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *Zip4Iterator[TA, TB, TC, TD]) Next() bool {
+	if !it.A.Next() {
+		it.err = itkit.Err(it.A)
+		return false
+	}
+	if !it.B.Next() {
+		it.err = itkit.Err(it.B)
+		return false
+	}
+	if !it.C.Next() {
+		it.err = itkit.Err(it.C)
+		return false
+	}
+	if !it.D.Next() {
+		it.err = itkit.Err(it.D)
+		return false
+	}
+	it.cur = ittuple.T4[TA, TB, TC, TD]{A: it.A.Value(), B: it.B.Value(), C: it.C.Value(), D: it.D.Value()}
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *Zip4Iterator[TA, TB, TC, TD]) Value() ittuple.T4[TA, TB, TC, TD] {
+	return it.cur
+}
+
+// Err returns the error reported by the first source iterator
+// that stopped the Zip4Iterator, if any.
+func (it *Zip4Iterator[TA, TB, TC, TD]) Err() error {
+	return it.err
+}
+
+// Close closes all source iterators, implementing the [io.Closer]
+// interface.
+func (it *Zip4Iterator[TA, TB, TC, TD]) Close() error {
+	return errors.Join(itkit.Close(it.A), itkit.Close(it.B), itkit.Close(it.C), itkit.Close(it.D))
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *Zip4Iterator[TA, TB, TC, TD]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.A).
+		Min(itkit.SizeHintOf(it.B)).
+		Min(itkit.SizeHintOf(it.C)).
+		Min(itkit.SizeHintOf(it.D))
+}
+
+// Zip4 returns an iterator that aggregates elements from the given
+// iterators into [ittuple.T4] values, stopping when the shortest
+// input iterator is exhausted.
+func Zip4[TA, TB, TC, TD any](a itkit.Iterator[TA], b itkit.Iterator[TB], c itkit.Iterator[TC], d itkit.Iterator[TD]) itkit.Iterator[ittuple.T4[TA, TB, TC, TD]] {
+	return &Zip4Iterator[TA, TB, TC, TD]{A: a, B: b, C: c, D: d}
+}
+
+// Zip5Iterator is an iterator aggregating the items of 5 source
+// iterators into [ittuple.T5] values.
+type Zip5Iterator[TA, TB, TC, TD, TE any] struct {
+	A itkit.Iterator[TA]
+	B itkit.Iterator[TB]
+	C itkit.Iterator[TC]
+	D itkit.Iterator[TD]
+	E itkit.Iterator[TE]
+
+	cur ittuple.T5[TA, TB, TC, TD, TE]
+	err error
+}
+
+// Ensure Zip5Iterator conforms to the Iterator protocol.
+var _ itkit.Iterator[ittuple.T5[struct{}, struct{}, struct{}, struct{}, struct{}]] = &Zip5Iterator[struct{}, struct{}, struct{}, struct{}, struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *Zip5Iterator[TA, TB, TC, TD, TE]) Next() bool {
+	if !it.A.Next() {
+		it.err = itkit.Err(it.A)
+		return false
+	}
+	if !it.B.Next() {
+		it.err = itkit.Err(it.B)
+		return false
+	}
+	if !it.C.Next() {
+		it.err = itkit.Err(it.C)
+		return false
+	}
+	if !it.D.Next() {
+		it.err = itkit.Err(it.D)
+		return false
+	}
+	if !it.E.Next() {
+		it.err = itkit.Err(it.E)
+		return false
+	}
+	it.cur = ittuple.T5[TA, TB, TC, TD, TE]{A: it.A.Value(), B: it.B.Value(), C: it.C.Value(), D: it.D.Value(), E: it.E.Value()}
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *Zip5Iterator[TA, TB, TC, TD, TE]) Value() ittuple.T5[TA, TB, TC, TD, TE] {
+	return it.cur
+}
+
+// Err returns the error reported by the first source iterator
+// that stopped the Zip5Iterator, if any.
+func (it *Zip5Iterator[TA, TB, TC, TD, TE]) Err() error {
+	return it.err
+}
+
+// Close closes all source iterators, implementing the [io.Closer]
+// interface.
+func (it *Zip5Iterator[TA, TB, TC, TD, TE]) Close() error {
+	return errors.Join(itkit.Close(it.A), itkit.Close(it.B), itkit.Close(it.C), itkit.Close(it.D), itkit.Close(it.E))
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *Zip5Iterator[TA, TB, TC, TD, TE]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.A).
+		Min(itkit.SizeHintOf(it.B)).
+		Min(itkit.SizeHintOf(it.C)).
+		Min(itkit.SizeHintOf(it.D)).
+		Min(itkit.SizeHintOf(it.E))
+}
+
+// Zip5 returns an iterator that aggregates elements from the given
+// iterators into [ittuple.T5] values, stopping when the shortest
+// input iterator is exhausted.
+func Zip5[TA, TB, TC, TD, TE any](a itkit.Iterator[TA], b itkit.Iterator[TB], c itkit.Iterator[TC], d itkit.Iterator[TD], e itkit.Iterator[TE]) itkit.Iterator[ittuple.T5[TA, TB, TC, TD, TE]] {
+	return &Zip5Iterator[TA, TB, TC, TD, TE]{A: a, B: b, C: c, D: d, E: e}
+}
+
+// Zip6Iterator is an iterator aggregating the items of 6 source
+// iterators into [ittuple.T6] values.
+type Zip6Iterator[TA, TB, TC, TD, TE, TF any] struct {
+	A itkit.Iterator[TA]
+	B itkit.Iterator[TB]
+	C itkit.Iterator[TC]
+	D itkit.Iterator[TD]
+	E itkit.Iterator[TE]
+	F itkit.Iterator[TF]
+
+	cur ittuple.T6[TA, TB, TC, TD, TE, TF]
+	err error
+}
+
+// Ensure Zip6Iterator conforms to the Iterator protocol.
+var _ itkit.Iterator[ittuple.T6[struct{}, struct{}, struct{}, struct{}, struct{}, struct{}]] = &Zip6Iterator[struct{}, struct{}, struct{}, struct{}, struct{}, struct{}]{}
+
+// Next implements the [itkit.Iterator.Next] interface.
+func (it *Zip6Iterator[TA, TB, TC, TD, TE, TF]) Next() bool {
+	if !it.A.Next() {
+		it.err = itkit.Err(it.A)
+		return false
+	}
+	if !it.B.Next() {
+		it.err = itkit.Err(it.B)
+		return false
+	}
+	if !it.C.Next() {
+		it.err = itkit.Err(it.C)
+		return false
+	}
+	if !it.D.Next() {
+		it.err = itkit.Err(it.D)
+		return false
+	}
+	if !it.E.Next() {
+		it.err = itkit.Err(it.E)
+		return false
+	}
+	if !it.F.Next() {
+		it.err = itkit.Err(it.F)
+		return false
+	}
+	it.cur = ittuple.T6[TA, TB, TC, TD, TE, TF]{A: it.A.Value(), B: it.B.Value(), C: it.C.Value(), D: it.D.Value(), E: it.E.Value(), F: it.F.Value()}
+	return true
+}
+
+// Value implements the [itkit.Iterator.Value] interface.
+func (it *Zip6Iterator[TA, TB, TC, TD, TE, TF]) Value() ittuple.T6[TA, TB, TC, TD, TE, TF] {
+	return it.cur
+}
+
+// Err returns the error reported by the first source iterator
+// that stopped the Zip6Iterator, if any.
+func (it *Zip6Iterator[TA, TB, TC, TD, TE, TF]) Err() error {
+	return it.err
+}
+
+// Close closes all source iterators, implementing the [io.Closer]
+// interface.
+func (it *Zip6Iterator[TA, TB, TC, TD, TE, TF]) Close() error {
+	return errors.Join(itkit.Close(it.A), itkit.Close(it.B), itkit.Close(it.C), itkit.Close(it.D), itkit.Close(it.E), itkit.Close(it.F))
+}
+
+// SizeHint implements the [itkit.SizeHinter] interface.
+func (it *Zip6Iterator[TA, TB, TC, TD, TE, TF]) SizeHint() itkit.SizeHint {
+	return itkit.SizeHintOf(it.A).
+		Min(itkit.SizeHintOf(it.B)).
+		Min(itkit.SizeHintOf(it.C)).
+		Min(itkit.SizeHintOf(it.D)).
+		Min(itkit.SizeHintOf(it.E)).
+		Min(itkit.SizeHintOf(it.F))
+}
+
+// Zip6 returns an iterator that aggregates elements from the given
+// iterators into [ittuple.T6] values, stopping when the shortest
+// input iterator is exhausted.
+func Zip6[TA, TB, TC, TD, TE, TF any](a itkit.Iterator[TA], b itkit.Iterator[TB], c itkit.Iterator[TC], d itkit.Iterator[TD], e itkit.Iterator[TE], f itkit.Iterator[TF]) itkit.Iterator[ittuple.T6[TA, TB, TC, TD, TE, TF]] {
+	return &Zip6Iterator[TA, TB, TC, TD, TE, TF]{A: a, B: b, C: c, D: d, E: e, F: f}
+}
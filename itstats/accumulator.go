@@ -0,0 +1,59 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itstats
+
+import (
+	"fmt"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// Accumulator is implemented by statistics fed item by item.
+type Accumulator[T any] interface {
+	// Add feeds the item v to the statistic.
+	Add(v T)
+}
+
+// Feed consumes the given iterator it, feeding all items to the
+// given [Accumulator] acc, and returns acc.
+//
+// Feed panics with [itkit.ErrInfinite] if the iterator reports to be
+// infinite.
+func Feed[T any, A Accumulator[T]](acc A, it itkit.Iterator[T]) A {
+	if itkit.SizeHintOf(it).Infinite {
+		panic(fmt.Errorf("itstats: %w", itkit.ErrInfinite))
+	}
+
+	for it.Next() {
+		acc.Add(it.Value())
+	}
+	return acc
+}
+
+// Tally counts the items fed to it.
+type Tally[T any] struct {
+	n int
+}
+
+// Add implements the [Accumulator.Add] interface.
+func (t *Tally[T]) Add(T) { t.n += 1 }
+
+// Count returns the number of items fed so far.
+func (t *Tally[T]) Count() int { return t.n }
+
+// Count consumes the given iterator and returns the number of items.
+func Count[T any](it itkit.Iterator[T]) int {
+	return Feed(&Tally[T]{}, it).Count()
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itstats
+
+import (
+	"slices"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+// Counter counts the occurrences of distinct items fed to it.
+//
+// The zero value is ready to use.
+type Counter[T comparable] struct {
+	index  map[T]int
+	counts []ittuple.T2[T, int]
+	total  int
+}
+
+// Add implements the [Accumulator.Add] interface.
+func (c *Counter[T]) Add(v T) {
+	c.AddN(v, 1)
+}
+
+// AddN counts the item v n times.
+func (c *Counter[T]) AddN(v T, n int) {
+	if c.index == nil {
+		c.index = make(map[T]int)
+	}
+
+	i, ok := c.index[v]
+	if !ok {
+		i = len(c.counts)
+		c.index[v] = i
+		c.counts = append(c.counts, ittuple.T2[T, int]{Left: v})
+	}
+	c.counts[i].Right += n
+	c.total += n
+}
+
+// Get returns the number of occurrences of the item v.
+func (c *Counter[T]) Get(v T) int {
+	if i, ok := c.index[v]; ok {
+		return c.counts[i].Right
+	}
+	return 0
+}
+
+// Len returns the number of distinct items.
+func (c *Counter[T]) Len() int { return len(c.counts) }
+
+// Total returns the number of items fed so far.
+func (c *Counter[T]) Total() int { return c.total }
+
+// MostCommon returns the n most common items together with their
+// number of occurrences, ordered from the most common to the least
+// common.  Items with the same number of occurrences are ordered by
+// their first occurrence.  All items are returned if n is negative.
+func (c *Counter[T]) MostCommon(n int) []ittuple.T2[T, int] {
+	out := slices.Clone(c.counts)
+	slices.SortStableFunc(out, func(a, b ittuple.T2[T, int]) int {
+		return b.Right - a.Right
+	})
+
+	if n >= 0 && n < len(out) {
+		out = out[:n]
+	}
+	return out
+}
+
+// CounterOf consumes the given iterator and returns a [Counter]
+// holding the number of occurrences of its distinct items.
+func CounterOf[T comparable](it itkit.Iterator[T]) *Counter[T] {
+	return Feed(&Counter[T]{}, it)
+}
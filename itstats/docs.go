@@ -0,0 +1,26 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package itstats provides single-pass statistics over iterators.
+//
+// Every statistic is available as an accumulator fed item by item
+// and as a function consuming an iterator at once.
+//
+// Functions:
+//   - [Count] - counts the items of an iterator
+//   - [Min], [Max] - the least and the greatest item
+//   - [MinBy], [MaxBy] - like [Min] and [Max], comparing keys
+//   - [Mean], [Variance], [StdDev] - moments of numeric items
+//   - [CounterOf] - counts the occurrences of every distinct item
+package itstats
@@ -0,0 +1,98 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itstats
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/0x5a17ed/itkit"
+	"github.com/0x5a17ed/itkit/itlib"
+)
+
+// Extreme tracks the least or the greatest item fed to it, comparing
+// the keys of the items.  Of multiple items sharing the same extreme
+// key, the first one fed is kept.
+type Extreme[T any, K constraints.Ordered] struct {
+	fn       itlib.KeyFn[T, K]
+	greatest bool
+
+	v  T
+	k  K
+	ok bool
+}
+
+// Add implements the [Accumulator.Add] interface.
+func (e *Extreme[T, K]) Add(v T) {
+	k := e.fn(v)
+	if !e.ok || (e.greatest && k > e.k) || (!e.greatest && k < e.k) {
+		e.v, e.k, e.ok = v, k, true
+	}
+}
+
+// Value returns the extreme item fed so far.  The return value ok is
+// false if no items have been fed yet.
+func (e *Extreme[T, K]) Value() (v T, ok bool) {
+	return e.v, e.ok
+}
+
+func identity[T any](v T) T { return v }
+
+// NewMin returns an [Extreme] tracking the least item.
+func NewMin[T constraints.Ordered]() *Extreme[T, T] {
+	return &Extreme[T, T]{fn: identity[T]}
+}
+
+// NewMax returns an [Extreme] tracking the greatest item.
+func NewMax[T constraints.Ordered]() *Extreme[T, T] {
+	return &Extreme[T, T]{fn: identity[T], greatest: true}
+}
+
+// NewMinBy returns an [Extreme] tracking the item with the least key
+// as returned by the given [itlib.KeyFn] fn.
+func NewMinBy[T any, K constraints.Ordered](fn itlib.KeyFn[T, K]) *Extreme[T, K] {
+	return &Extreme[T, K]{fn: fn}
+}
+
+// NewMaxBy returns an [Extreme] tracking the item with the greatest
+// key as returned by the given [itlib.KeyFn] fn.
+func NewMaxBy[T any, K constraints.Ordered](fn itlib.KeyFn[T, K]) *Extreme[T, K] {
+	return &Extreme[T, K]{fn: fn, greatest: true}
+}
+
+// Min consumes the given iterator and returns the least item.  The
+// return value ok is false if the iterator yielded no items.
+func Min[T constraints.Ordered](it itkit.Iterator[T]) (v T, ok bool) {
+	return Feed(NewMin[T](), it).Value()
+}
+
+// Max consumes the given iterator and returns the greatest item.
+// The return value ok is false if the iterator yielded no items.
+func Max[T constraints.Ordered](it itkit.Iterator[T]) (v T, ok bool) {
+	return Feed(NewMax[T](), it).Value()
+}
+
+// MinBy consumes the given iterator and returns the item with the
+// least key as returned by fn.  The return value ok is false if the
+// iterator yielded no items.
+func MinBy[T any, K constraints.Ordered](it itkit.Iterator[T], fn itlib.KeyFn[T, K]) (v T, ok bool) {
+	return Feed(NewMinBy(fn), it).Value()
+}
+
+// MaxBy consumes the given iterator and returns the item with the
+// greatest key as returned by fn.  The return value ok is false if
+// the iterator yielded no items.
+func MaxBy[T any, K constraints.Ordered](it itkit.Iterator[T], fn itlib.KeyFn[T, K]) (v T, ok bool) {
+	return Feed(NewMaxBy(fn), it).Value()
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itstats
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/0x5a17ed/itkit"
+)
+
+// Number is a constraint permitting any integer or floating-point
+// type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Moments tracks the count, the mean and the variance of the numbers
+// fed to it using Welford's numerically stable online algorithm.
+//
+// The zero value is ready to use.
+type Moments[T Number] struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+// Add implements the [Accumulator.Add] interface.
+func (m *Moments[T]) Add(v T) {
+	x := float64(v)
+
+	m.n += 1
+	d := x - m.mean
+	m.mean += d / float64(m.n)
+	m.m2 += d * (x - m.mean)
+}
+
+// Count returns the number of items fed so far.
+func (m *Moments[T]) Count() int { return m.n }
+
+// Mean returns the arithmetic mean of the items fed so far, NaN if
+// no items have been fed yet.
+func (m *Moments[T]) Mean() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.mean
+}
+
+// Variance returns the population variance of the items fed so far,
+// NaN if no items have been fed yet.
+func (m *Moments[T]) Variance() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.m2 / float64(m.n)
+}
+
+// SampleVariance returns the sample variance of the items fed so
+// far, NaN if less than two items have been fed yet.
+func (m *Moments[T]) SampleVariance() float64 {
+	if m.n < 2 {
+		return math.NaN()
+	}
+	return m.m2 / float64(m.n-1)
+}
+
+// StdDev returns the population standard deviation of the items fed
+// so far, NaN if no items have been fed yet.
+func (m *Moments[T]) StdDev() float64 {
+	return math.Sqrt(m.Variance())
+}
+
+// SampleStdDev returns the sample standard deviation of the items
+// fed so far, NaN if less than two items have been fed yet.
+func (m *Moments[T]) SampleStdDev() float64 {
+	return math.Sqrt(m.SampleVariance())
+}
+
+// Mean consumes the given iterator and returns the arithmetic mean
+// of its items, NaN if the iterator yielded no items.
+func Mean[T Number](it itkit.Iterator[T]) float64 {
+	return Feed(&Moments[T]{}, it).Mean()
+}
+
+// Variance consumes the given iterator and returns the population
+// variance of its items, NaN if the iterator yielded no items.
+func Variance[T Number](it itkit.Iterator[T]) float64 {
+	return Feed(&Moments[T]{}, it).Variance()
+}
+
+// StdDev consumes the given iterator and returns the population
+// standard deviation of its items, NaN if the iterator yielded no
+// items.
+func StdDev[T Number](it itkit.Iterator[T]) float64 {
+	return Feed(&Moments[T]{}, it).StdDev()
+}
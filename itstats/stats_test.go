@@ -0,0 +1,141 @@
+// Copyright (c) 2024 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itstats_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/0x5a17ed/itkit/iters/rangeit"
+	"github.com/0x5a17ed/itkit/iters/sliceit"
+	"github.com/0x5a17ed/itkit/itstats"
+	"github.com/0x5a17ed/itkit/ittuple"
+)
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, 0, itstats.Count(sliceit.In([]string{})))
+	assert.Equal(t, 7, itstats.Count(rangeit.Range(7)))
+
+	assert.Panics(t, func() { itstats.Count(rangeit.Count[int]()) })
+}
+
+func TestMinMax(t *testing.T) {
+	_, ok := itstats.Min(sliceit.In([]int{}))
+	assert.False(t, ok)
+	_, ok = itstats.Max(sliceit.In([]int{}))
+	assert.False(t, ok)
+
+	v, ok := itstats.Min(sliceit.In([]int{3, -1, 4, -1, 5}))
+	assert.True(t, ok)
+	assert.Equal(t, -1, v)
+
+	s, ok := itstats.Max(sliceit.In([]string{"pear", "apple", "plum"}))
+	assert.True(t, ok)
+	assert.Equal(t, "plum", s)
+}
+
+func TestMinMaxBy(t *testing.T) {
+	words := []string{"kiwi", "fig", "banana", "date", "cherry"}
+
+	v, ok := itstats.MinBy(sliceit.In(words), func(s string) int { return len(s) })
+	assert.True(t, ok)
+	assert.Equal(t, "fig", v)
+
+	// The first of multiple extreme items is kept.
+	v, ok = itstats.MaxBy(sliceit.In(words), func(s string) int { return len(s) })
+	assert.True(t, ok)
+	assert.Equal(t, "banana", v)
+}
+
+func TestExtreme(t *testing.T) {
+	acc := itstats.NewMax[float64]()
+	_, ok := acc.Value()
+	assert.False(t, ok)
+
+	for _, v := range []float64{1.5, -2, 7.25, 7.25, 0} {
+		acc.Add(v)
+	}
+	v, ok := acc.Value()
+	assert.True(t, ok)
+	assert.Equal(t, 7.25, v)
+}
+
+func TestMoments(t *testing.T) {
+	var m itstats.Moments[int]
+	assert.True(t, math.IsNaN(m.Mean()))
+	assert.True(t, math.IsNaN(m.Variance()))
+
+	m.Add(4)
+	assert.Equal(t, 4.0, m.Mean())
+	assert.Equal(t, 0.0, m.Variance())
+	assert.True(t, math.IsNaN(m.SampleVariance()))
+
+	for _, v := range []int{2, 4, 4, 5, 5, 7, 9} {
+		m.Add(v)
+	}
+	assert.Equal(t, 8, m.Count())
+	assert.InDelta(t, 5.0, m.Mean(), 1e-12)
+	assert.InDelta(t, 4.0, m.Variance(), 1e-12)
+	assert.InDelta(t, 2.0, m.StdDev(), 1e-12)
+	assert.InDelta(t, 32.0/7, m.SampleVariance(), 1e-12)
+	assert.InDelta(t, math.Sqrt(32.0/7), m.SampleStdDev(), 1e-12)
+}
+
+func TestMoments_Stable(t *testing.T) {
+	// Large offsets ruin the naive sum of squares approach.
+	s := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+
+	assert.InDelta(t, 1e9+10, itstats.Mean(sliceit.In(s)), 1e-6)
+	assert.InDelta(t, 22.5, itstats.Variance(sliceit.In(s)), 1e-6)
+	assert.InDelta(t, math.Sqrt(22.5), itstats.StdDev(sliceit.In(s)), 1e-6)
+}
+
+func TestCounter(t *testing.T) {
+	c := itstats.CounterOf(sliceit.In([]string{"b", "a", "c", "a", "b", "a", "d"}))
+
+	assert.Equal(t, 4, c.Len())
+	assert.Equal(t, 7, c.Total())
+	assert.Equal(t, 3, c.Get("a"))
+	assert.Equal(t, 0, c.Get("z"))
+
+	assert.Equal(t, []ittuple.T2[string, int]{
+		{Left: "a", Right: 3},
+		{Left: "b", Right: 2},
+	}, c.MostCommon(2))
+
+	// Ties are ordered by first occurrence.
+	assert.Equal(t, []ittuple.T2[string, int]{
+		{Left: "a", Right: 3},
+		{Left: "b", Right: 2},
+		{Left: "c", Right: 1},
+		{Left: "d", Right: 1},
+	}, c.MostCommon(-1))
+	assert.Empty(t, c.MostCommon(0))
+
+	c.AddN("d", 5)
+	assert.Equal(t, ittuple.T2[string, int]{Left: "d", Right: 6}, c.MostCommon(1)[0])
+	assert.Equal(t, 12, c.Total())
+}
+
+func TestCounter_Zero(t *testing.T) {
+	var c itstats.Counter[int]
+	assert.Equal(t, 0, c.Get(1))
+	assert.Empty(t, c.MostCommon(3))
+
+	c.Add(1)
+	assert.Equal(t, 1, c.Get(1))
+}
@@ -53,3 +53,157 @@ func (t T2[TL, TR]) String() string {
 func NewT2[TL, TR any](left TL, right TR) T2[TL, TR] {
 	return T2[TL, TR]{Left: left, Right: right}
 }
+
+// T3 represents a generic tuple holding 3 values.
+type T3[TA, TB, TC any] struct {
+	A TA
+	B TB
+	C TC
+}
+
+// Len returns the number of values held by the tuple.
+func (t T3[TA, TB, TC]) Len() int {
+	return 3
+}
+
+// Values returns the values held by the tuple.
+func (t T3[TA, TB, TC]) Values() (TA, TB, TC) {
+	return t.A, t.B, t.C
+}
+
+// Array returns an array of the tuple values.
+func (t T3[TA, TB, TC]) Array() [3]any {
+	return [3]any{t.A, t.B, t.C}
+}
+
+// Slice returns a slice of the tuple values.
+func (t T3[TA, TB, TC]) Slice() []any {
+	a := t.Array()
+	return a[:]
+}
+
+// String returns the string representation of the tuple.
+func (t T3[TA, TB, TC]) String() string {
+	return fmt.Sprintf("[%#v %#v %#v]", t.Slice()...)
+}
+
+func NewT3[TA, TB, TC any](a TA, b TB, c TC) T3[TA, TB, TC] {
+	return T3[TA, TB, TC]{A: a, B: b, C: c}
+}
+
+// T4 represents a generic tuple holding 4 values.
+type T4[TA, TB, TC, TD any] struct {
+	A TA
+	B TB
+	C TC
+	D TD
+}
+
+// Len returns the number of values held by the tuple.
+func (t T4[TA, TB, TC, TD]) Len() int {
+	return 4
+}
+
+// Values returns the values held by the tuple.
+func (t T4[TA, TB, TC, TD]) Values() (TA, TB, TC, TD) {
+	return t.A, t.B, t.C, t.D
+}
+
+// Array returns an array of the tuple values.
+func (t T4[TA, TB, TC, TD]) Array() [4]any {
+	return [4]any{t.A, t.B, t.C, t.D}
+}
+
+// Slice returns a slice of the tuple values.
+func (t T4[TA, TB, TC, TD]) Slice() []any {
+	a := t.Array()
+	return a[:]
+}
+
+// String returns the string representation of the tuple.
+func (t T4[TA, TB, TC, TD]) String() string {
+	return fmt.Sprintf("[%#v %#v %#v %#v]", t.Slice()...)
+}
+
+func NewT4[TA, TB, TC, TD any](a TA, b TB, c TC, d TD) T4[TA, TB, TC, TD] {
+	return T4[TA, TB, TC, TD]{A: a, B: b, C: c, D: d}
+}
+
+// T5 represents a generic tuple holding 5 values.
+type T5[TA, TB, TC, TD, TE any] struct {
+	A TA
+	B TB
+	C TC
+	D TD
+	E TE
+}
+
+// Len returns the number of values held by the tuple.
+func (t T5[TA, TB, TC, TD, TE]) Len() int {
+	return 5
+}
+
+// Values returns the values held by the tuple.
+func (t T5[TA, TB, TC, TD, TE]) Values() (TA, TB, TC, TD, TE) {
+	return t.A, t.B, t.C, t.D, t.E
+}
+
+// Array returns an array of the tuple values.
+func (t T5[TA, TB, TC, TD, TE]) Array() [5]any {
+	return [5]any{t.A, t.B, t.C, t.D, t.E}
+}
+
+// Slice returns a slice of the tuple values.
+func (t T5[TA, TB, TC, TD, TE]) Slice() []any {
+	a := t.Array()
+	return a[:]
+}
+
+// String returns the string representation of the tuple.
+func (t T5[TA, TB, TC, TD, TE]) String() string {
+	return fmt.Sprintf("[%#v %#v %#v %#v %#v]", t.Slice()...)
+}
+
+func NewT5[TA, TB, TC, TD, TE any](a TA, b TB, c TC, d TD, e TE) T5[TA, TB, TC, TD, TE] {
+	return T5[TA, TB, TC, TD, TE]{A: a, B: b, C: c, D: d, E: e}
+}
+
+// T6 represents a generic tuple holding 6 values.
+type T6[TA, TB, TC, TD, TE, TF any] struct {
+	A TA
+	B TB
+	C TC
+	D TD
+	E TE
+	F TF
+}
+
+// Len returns the number of values held by the tuple.
+func (t T6[TA, TB, TC, TD, TE, TF]) Len() int {
+	return 6
+}
+
+// Values returns the values held by the tuple.
+func (t T6[TA, TB, TC, TD, TE, TF]) Values() (TA, TB, TC, TD, TE, TF) {
+	return t.A, t.B, t.C, t.D, t.E, t.F
+}
+
+// Array returns an array of the tuple values.
+func (t T6[TA, TB, TC, TD, TE, TF]) Array() [6]any {
+	return [6]any{t.A, t.B, t.C, t.D, t.E, t.F}
+}
+
+// Slice returns a slice of the tuple values.
+func (t T6[TA, TB, TC, TD, TE, TF]) Slice() []any {
+	a := t.Array()
+	return a[:]
+}
+
+// String returns the string representation of the tuple.
+func (t T6[TA, TB, TC, TD, TE, TF]) String() string {
+	return fmt.Sprintf("[%#v %#v %#v %#v %#v %#v]", t.Slice()...)
+}
+
+func NewT6[TA, TB, TC, TD, TE, TF any](a TA, b TB, c TC, d TD, e TE, f TF) T6[TA, TB, TC, TD, TE, TF] {
+	return T6[TA, TB, TC, TD, TE, TF]{A: a, B: b, C: c, D: d, E: e, F: f}
+}
@@ -48,3 +48,51 @@ func TestTuple2_String(t *testing.T) {
 	tup := ittuple.NewT2("Left", "Right")
 	assertpkg.Equalf(t, `["Left" "Right"]`, tup.String(), "String()")
 }
+
+func TestTuple3(t *testing.T) {
+	tup := ittuple.NewT3("A", "B", "C")
+	assertpkg.Equalf(t, 3, tup.Len(), "Len()")
+
+	gotA, gotB, gotC := tup.Values()
+	assertpkg.Equalf(t, []string{"A", "B", "C"}, []string{gotA, gotB, gotC}, "Values()")
+
+	assertpkg.Equalf(t, [3]any{"A", "B", "C"}, tup.Array(), "Array()")
+	assertpkg.Equalf(t, []any{"A", "B", "C"}, tup.Slice(), "Slice()")
+	assertpkg.Equalf(t, `["A" "B" "C"]`, tup.String(), "String()")
+}
+
+func TestTuple4(t *testing.T) {
+	tup := ittuple.NewT4("A", "B", "C", "D")
+	assertpkg.Equalf(t, 4, tup.Len(), "Len()")
+
+	gotA, gotB, gotC, gotD := tup.Values()
+	assertpkg.Equalf(t, []string{"A", "B", "C", "D"}, []string{gotA, gotB, gotC, gotD}, "Values()")
+
+	assertpkg.Equalf(t, [4]any{"A", "B", "C", "D"}, tup.Array(), "Array()")
+	assertpkg.Equalf(t, []any{"A", "B", "C", "D"}, tup.Slice(), "Slice()")
+	assertpkg.Equalf(t, `["A" "B" "C" "D"]`, tup.String(), "String()")
+}
+
+func TestTuple5(t *testing.T) {
+	tup := ittuple.NewT5("A", "B", "C", "D", "E")
+	assertpkg.Equalf(t, 5, tup.Len(), "Len()")
+
+	gotA, gotB, gotC, gotD, gotE := tup.Values()
+	assertpkg.Equalf(t, []string{"A", "B", "C", "D", "E"}, []string{gotA, gotB, gotC, gotD, gotE}, "Values()")
+
+	assertpkg.Equalf(t, [5]any{"A", "B", "C", "D", "E"}, tup.Array(), "Array()")
+	assertpkg.Equalf(t, []any{"A", "B", "C", "D", "E"}, tup.Slice(), "Slice()")
+	assertpkg.Equalf(t, `["A" "B" "C" "D" "E"]`, tup.String(), "String()")
+}
+
+func TestTuple6(t *testing.T) {
+	tup := ittuple.NewT6("A", "B", "C", "D", "E", "F")
+	assertpkg.Equalf(t, 6, tup.Len(), "Len()")
+
+	gotA, gotB, gotC, gotD, gotE, gotF := tup.Values()
+	assertpkg.Equalf(t, []string{"A", "B", "C", "D", "E", "F"}, []string{gotA, gotB, gotC, gotD, gotE, gotF}, "Values()")
+
+	assertpkg.Equalf(t, [6]any{"A", "B", "C", "D", "E", "F"}, tup.Array(), "Array()")
+	assertpkg.Equalf(t, []any{"A", "B", "C", "D", "E", "F"}, tup.Slice(), "Slice()")
+	assertpkg.Equalf(t, `["A" "B" "C" "D" "E" "F"]`, tup.String(), "String()")
+}
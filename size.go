@@ -0,0 +1,138 @@
+// Copyright (c) 2022 individual contributors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// <https://www.apache.org/licenses/LICENSE-2.0>
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package itkit
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInfinite is reported when an infinite iterator is about
+	// to be consumed entirely.
+	ErrInfinite = errors.New("infinite iterator")
+)
+
+// SizeHint describes bounds on the number of items an iterator has
+// yet to yield.
+type SizeHint struct {
+	// Lower is the minimum number of items left.
+	Lower int
+
+	// Upper is the maximum number of items left, a negative value
+	// means the maximum is unknown.
+	Upper int
+
+	// Infinite reports whenever the iterator yields items forever.
+	Infinite bool
+}
+
+var (
+	// UnknownSize describes an iterator that may yield any number
+	// of items.
+	UnknownSize = SizeHint{Lower: 0, Upper: -1}
+
+	// InfiniteSize describes an iterator yielding items forever.
+	InfiniteSize = SizeHint{Lower: 0, Upper: -1, Infinite: true}
+)
+
+// ExactSize returns a [SizeHint] describing an iterator yielding
+// exactly n items.
+func ExactSize(n int) SizeHint {
+	return SizeHint{Lower: n, Upper: n}
+}
+
+// Exact returns the exact number of items left and true if the
+// lower and the upper bound match and false otherwise.
+func (h SizeHint) Exact() (int, bool) {
+	return h.Lower, !h.Infinite && h.Lower == h.Upper
+}
+
+// Bounded reports whenever the upper bound is known.
+func (h SizeHint) Bounded() bool {
+	return !h.Infinite && h.Upper >= 0
+}
+
+// Min returns the [SizeHint] of an iterator stopping as soon as
+// either the iterator described by h or by o stops.
+func (h SizeHint) Min(o SizeHint) SizeHint {
+	switch {
+	case h.Infinite:
+		return o
+	case o.Infinite:
+		return h
+	}
+
+	out := SizeHint{Lower: min(h.Lower, o.Lower), Upper: -1}
+	switch {
+	case h.Bounded() && o.Bounded():
+		out.Upper = min(h.Upper, o.Upper)
+	case h.Bounded():
+		out.Upper = h.Upper
+	case o.Bounded():
+		out.Upper = o.Upper
+	}
+	return out
+}
+
+// Max returns the [SizeHint] of an iterator stopping only once
+// both the iterator described by h and by o stopped.
+func (h SizeHint) Max(o SizeHint) SizeHint {
+	if h.Infinite || o.Infinite {
+		return InfiniteSize
+	}
+
+	out := SizeHint{Lower: max(h.Lower, o.Lower), Upper: -1}
+	if h.Bounded() && o.Bounded() {
+		out.Upper = max(h.Upper, o.Upper)
+	}
+	return out
+}
+
+// Add returns the [SizeHint] of an iterator yielding the items of
+// the iterator described by h followed by the items of the iterator
+// described by o.
+func (h SizeHint) Add(o SizeHint) SizeHint {
+	if h.Infinite || o.Infinite {
+		return InfiniteSize
+	}
+
+	out := SizeHint{Lower: h.Lower + o.Lower, Upper: -1}
+	if h.Bounded() && o.Bounded() {
+		out.Upper = h.Upper + o.Upper
+	}
+	return out
+}
+
+// AtMost returns the [SizeHint] of an iterator yielding up to as
+// many items as the iterator described by h, like a filter.
+func (h SizeHint) AtMost() SizeHint {
+	return SizeHint{Lower: 0, Upper: h.Upper}
+}
+
+// A SizeHinter is implemented by iterators knowing bounds on the
+// number of items they have yet to yield.
+type SizeHinter interface {
+	// SizeHint returns the bounds on the number of items left.
+	SizeHint() SizeHint
+}
+
+// SizeHintOf returns the [SizeHint] of the given Iterator it if it
+// implements the [SizeHinter] protocol and [UnknownSize] otherwise.
+func SizeHintOf[T any](it Iterator[T]) SizeHint {
+	if h, ok := it.(SizeHinter); ok {
+		return h.SizeHint()
+	}
+	return UnknownSize
+}